@@ -8,8 +8,10 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"runtime"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Rasek91/skewb"
@@ -17,24 +19,17 @@ import (
 
 var (
 	allPreMoves   = []string{""}
-	allSolveMoves = map[int][]string{
-		0: {""},
-		1: {},
-		2: {},
-		3: {},
-		4: {},
-		5: {},
-		6: {},
-		7: {},
-		8: {},
-		9: {},
-	}
-	preMoves   = []string{"x", "x'", "x2", "y", "y'", "y2", "z", "z'", "z2"}
-	solveMoves = []string{"F", "F'", "f", "f'", "R", "R'", "r", "r'", "b", "b'"}
+	preMoveHashes = map[uint64]bool{}
+	solveMoves    = []skewb.Move{
+		skewb.F, skewb.FPrime, skewb.LittleF, skewb.LittleFPrime, skewb.R, skewb.RPrime, skewb.LittleR, skewb.LittleRPrime,
+		skewb.LittleB, skewb.LittleBPrime,
+	}
 )
 
 func iteratorPreMoves(previousMoves string, currentIteration, maxIteration int) {
-	for _, move := range preMoves {
+	for _, rotation := range skewb.WCARotations {
+		move := string(rotation)
+
 		if currentIteration == 1 {
 			if (previousMoves != "") && (len(strings.Split(previousMoves, " ")) == currentIteration) && (lastMoveIsDifferent(previousMoves, move)) {
 				move = fmt.Sprintf("%v %v", previousMoves, move)
@@ -49,10 +44,12 @@ func iteratorPreMoves(previousMoves string, currentIteration, maxIteration int)
 			iteratorPreMoves(move, currentIteration+1, maxIteration)
 		} else {
 			if len(strings.Split(move, " ")) == maxIteration {
+				s1 := skewb.NewFromScheme(skewb.DefaultScheme())
+				s1.ApplyRubiskewbMoves(move)
+				s2 := skewb.Skewb{}
+
 				if !slices.ContainsFunc(allPreMoves, func(newMove string) bool {
-					s1 := skewb.New("#FFFFFFFF", "#00FF00FF", "#FF0000FF", "#0000FFFF", "#D67200FF", "#FBFF00FF")
-					s2 := skewb.New("#FFFFFFFF", "#00FF00FF", "#FF0000FF", "#0000FFFF", "#D67200FF", "#FBFF00FF")
-					s1.ApplyRubiskewbMoves(move)
+					s2.ResetTo(skewb.DefaultScheme())
 					s2.ApplyRubiskewbMoves(newMove)
 
 					return s1.ExactEqual(&s2)
@@ -64,8 +61,13 @@ func iteratorPreMoves(previousMoves string, currentIteration, maxIteration int)
 	}
 }
 
-func iteratorSolveMoves(previousMoves string, currentIteration, maxIteration int) {
-	for _, move := range solveMoves {
+func iteratorSolveMoves(previousMoves string, currentIteration, maxIteration int) []string {
+	result := []string{}
+	s := skewb.Skewb{}
+
+	for _, solveMove := range solveMoves {
+		move := string(solveMove)
+
 		if currentIteration == 1 {
 			if (previousMoves != "") && (len(strings.Split(previousMoves, " ")) == currentIteration) && (lastMoveIsDifferent(previousMoves, move)) {
 				move = fmt.Sprintf("%v %v", previousMoves, move)
@@ -77,34 +79,83 @@ func iteratorSolveMoves(previousMoves string, currentIteration, maxIteration int
 		}
 
 		if currentIteration != maxIteration {
-			iteratorSolveMoves(move, currentIteration+1, maxIteration)
+			result = append(result, iteratorSolveMoves(move, currentIteration+1, maxIteration)...)
 		} else {
 			moveNumber := len(strings.Split(move, " "))
 
 			if moveNumber == maxIteration {
-				isIn := false
+				s.ResetTo(skewb.DefaultScheme())
+				s.ApplyRubiskewbMoves(move)
 
-				for i := 0; i <= moveNumber; i++ {
-					if slices.ContainsFunc(allPreMoves, func(newMove string) bool {
-						s1 := skewb.New("#FFFFFFFF", "#00FF00FF", "#FF0000FF", "#0000FFFF", "#D67200FF", "#FBFF00FF")
-						s2 := skewb.New("#FFFFFFFF", "#00FF00FF", "#FF0000FF", "#0000FFFF", "#D67200FF", "#FBFF00FF")
-						s1.ApplyRubiskewbMoves(move)
-						s2.ApplyRubiskewbMoves(newMove)
+				if !preMoveHashes[s.Hash()] {
+					result = append(result, move)
+				}
+			}
+		}
+	}
 
-						return s1.Equal(&s2)
-					}) {
-						isIn = true
+	return result
+}
 
-						break
-					}
-				}
+// hashPreMoves stores the hash of every orientation of every premove state, so a candidate is Equal to one
+// of allPreMoves exactly when its own Hash is in the set.
+func hashPreMoves() {
+	for _, preMove := range allPreMoves {
+		for _, rotation := range allPreMoves {
+			s := skewb.NewFromScheme(skewb.DefaultScheme())
+			s.ApplyRubiskewbMoves(strings.TrimSpace(fmt.Sprintf("%v %v", preMove, rotation)))
+			preMoveHashes[s.Hash()] = true
+		}
+	}
+}
 
-				if !isIn {
-					allSolveMoves[moveNumber] = append(allSolveMoves[moveNumber], move)
-				}
+// generateSolveMoves extends every sequence of the previous depth by one move. Only the previous depth is
+// kept in memory, the caller writes each depth out as soon as it is generated.
+func generateSolveMoves(previousMoves []string, iteration int) []string {
+	type branch struct {
+		index int
+		moves []string
+	}
+
+	jobs := make(chan int)
+	results := make(chan branch)
+	wg := sync.WaitGroup{}
+
+	for range runtime.NumCPU() {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for index := range jobs {
+				results <- branch{index: index, moves: iteratorSolveMoves(previousMoves[index], iteration, iteration)}
 			}
+		}()
+	}
+
+	go func() {
+		for index := range previousMoves {
+			jobs <- index
 		}
+
+		close(jobs)
+		wg.Wait()
+		close(results)
+	}()
+
+	branches := make([][]string, len(previousMoves))
+
+	for result := range results {
+		branches[result.index] = result.moves
+	}
+
+	extended := []string{}
+
+	for _, moves := range branches {
+		extended = append(extended, moves...)
 	}
+
+	return extended
 }
 
 func lastMoveIsDifferent(move1, move2 string) bool {
@@ -149,19 +200,10 @@ func main() {
 	iteratorPreMoves("", 1, 1)
 	iteratorPreMoves("", 1, 2)
 	iteratorPreMoves("", 1, 3)
+	hashPreMoves()
 	fmt.Printf("%s %v premoves finished\n", time.Since(previousTime), len(allPreMoves))
 
-	for i := 1; i <= 8; i++ {
-		previousTime := time.Now()
-
-		for _, solveMove := range allSolveMoves[i-1] {
-			iteratorSolveMoves(solveMove, i, i)
-		}
-
-		fmt.Printf("%s %v %v mover scrambles finished\n", time.Since(previousTime), len(allSolveMoves[i]), i)
-	}
-
-	archive, err := os.OpenFile(filepath.Join("algorithms", "moves.zip"), os.O_CREATE|os.O_WRONLY, 0644)
+	archive, err := os.OpenFile(filepath.Join("algorithms", "moves.zip"), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
 
 	if err != nil {
 		panic(err)
@@ -176,7 +218,7 @@ func main() {
 		panic(err)
 	}
 
-	content, err := json.MarshalIndent(allPreMoves, "", "\t")
+	content, err := json.MarshalIndent(slices.Sorted(slices.Values(allPreMoves)), "", "\t")
 
 	if err != nil {
 		panic(err)
@@ -187,20 +229,31 @@ func main() {
 		panic(err)
 	}
 
-	ioWriter, err = zipWriter.Create("allSolveMoves.json")
+	// Each depth is written as JSON Lines as soon as it is generated, so only two depths are ever in memory.
+	// Depths are written in increasing order and sorted, so regenerating the archive gives the same bytes.
+	ioWriter, err = zipWriter.Create("allSolveMoves.jsonl")
 
 	if err != nil {
 		panic(err)
 	}
 
-	content, err = json.MarshalIndent(allSolveMoves, "", "\t")
+	encoder := json.NewEncoder(ioWriter)
+	depthMoves := []string{""}
 
-	if err != nil {
-		panic(err)
-	}
+	for i := 0; i <= 8; i++ {
+		previousTime := time.Now()
 
-	reader = bytes.NewReader(content)
-	if _, err := io.Copy(ioWriter, reader); err != nil {
-		panic(err)
+		if i > 0 {
+			depthMoves = generateSolveMoves(depthMoves, i)
+			slices.Sort(depthMoves)
+		}
+
+		for _, moves := range depthMoves {
+			if err := encoder.Encode(skewb.SolveMovesLine{Depth: i, Moves: moves}); err != nil {
+				panic(err)
+			}
+		}
+
+		fmt.Printf("%s %v %v mover scrambles finished\n", time.Since(previousTime), len(depthMoves), i)
 	}
 }
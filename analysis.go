@@ -0,0 +1,291 @@
+package skewb
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type CenterRotationCounter interface {
+	CenterRotationCount() int
+	SolveCenterRotations() (string, error)
+}
+
+func (s *Skewb) CenterRotationCount() int {
+	relative, err := s.relativeFacelets()
+
+	if err != nil {
+		return -1
+	}
+
+	count := 0
+
+	for i, code := range relative[24:] {
+		if int(code) != i {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (s *Skewb) SolveCenterRotations() (string, error) {
+	relative, err := s.relativeFacelets()
+
+	if err != nil {
+		return "", err
+	}
+
+	initSolver()
+
+	centersOnly := solvedFacelets
+	copy(centersOnly[24:], relative[24:])
+
+	solution, err := solveFacelets(centersOnly)
+
+	if err != nil {
+		return "", err
+	}
+
+	return joinMoves(solution), nil
+}
+
+type SolvedPiecesCounter interface {
+	SolvedCorners() int
+	SolvedCenters() int
+}
+
+func (s *Skewb) SolvedCorners() int {
+	count := 0
+
+	for _, c := range []struct {
+		colors  [3]string
+		centers [3]string
+	}{
+		{colors: s.GetUFRCornerColors(), centers: [3]string{s.GetUpCenterColor(), s.GetFrontCenterColor(), s.GetRightCenterColor()}},
+		{colors: s.GetURBCornerColors(), centers: [3]string{s.GetUpCenterColor(), s.GetRightCenterColor(), s.GetBackCenterColor()}},
+		{colors: s.GetULFCornerColors(), centers: [3]string{s.GetUpCenterColor(), s.GetLeftCenterColor(), s.GetFrontCenterColor()}},
+		{colors: s.GetUBLCornerColors(), centers: [3]string{s.GetUpCenterColor(), s.GetBackCenterColor(), s.GetLeftCenterColor()}},
+		{colors: s.GetDRFCornerColors(), centers: [3]string{s.GetDownCenterColor(), s.GetRightCenterColor(), s.GetFrontCenterColor()}},
+		{colors: s.GetDBRCornerColors(), centers: [3]string{s.GetDownCenterColor(), s.GetBackCenterColor(), s.GetRightCenterColor()}},
+		{colors: s.GetDFLCornerColors(), centers: [3]string{s.GetDownCenterColor(), s.GetFrontCenterColor(), s.GetLeftCenterColor()}},
+		{colors: s.GetDLBCornerColors(), centers: [3]string{s.GetDownCenterColor(), s.GetLeftCenterColor(), s.GetBackCenterColor()}},
+	} {
+		if c.colors == c.centers {
+			count++
+		}
+	}
+
+	return count
+}
+
+// SolvedCenters counts the centers in place relative to the colors of the ufr corner, the corner WCA face
+// turns never move, so it is 6 minus CenterRotationCount.
+func (s *Skewb) SolvedCenters() int {
+	relative, err := s.relativeFacelets()
+
+	if err != nil {
+		return -1
+	}
+
+	count := 0
+
+	for i, code := range relative[24:] {
+		if int(code) == i {
+			count++
+		}
+	}
+
+	return count
+}
+
+type DistinctColorCounter interface {
+	DistinctColorCount() int
+}
+
+func (s *Skewb) DistinctColorCount() int {
+	colors := map[string]bool{}
+
+	for _, color := range s.faceletColors() {
+		colors[color] = true
+	}
+
+	return len(colors)
+}
+
+type SledgehammerChecker interface {
+	SledgehammerSolves() bool
+}
+
+var sledgehammer = "R' L R L'"
+
+func (s *Skewb) SledgehammerSolves() bool {
+	for _, rotation := range frontRotations {
+		clone := s.clone()
+
+		if rotation != "" {
+			clone.ApplyWCAMoves(rotation)
+		}
+
+		clone.ApplyWCAMoves(sledgehammer)
+
+		if clone.IsSolved() {
+			return true
+		}
+	}
+
+	return false
+}
+
+type SolvedChecker interface {
+	IsSolved() bool
+}
+
+func (s *Skewb) IsSolved() bool {
+	relative, err := s.relativeFacelets()
+
+	return err == nil && relative == solvedFacelets
+}
+
+var (
+	ErrColorCount = errors.New("a skewb color has to be on exactly five stickers")
+
+	stickersPerColor = 5
+)
+
+type Validator interface {
+	ColorCounts() map[string]int
+	Validate() error
+	IsValid() bool
+}
+
+// ColorCounts returns how many stickers have each color. A valid skewb has six colors on five stickers
+// each, one center and four corner stickers.
+func (s *Skewb) ColorCounts() map[string]int {
+	counts := map[string]int{}
+
+	for _, color := range s.faceletColors() {
+		counts[color]++
+	}
+
+	return counts
+}
+
+// Validate checks first that every color is on five stickers, returning an error wrapping ErrColorCount
+// that names the first color that is not, and then that the state can be reached from solved, returning
+// ErrUnsolvable otherwise.
+func (s *Skewb) Validate() error {
+	counts := s.ColorCounts()
+
+	for _, color := range s.faceletColors() {
+		if counts[color] != stickersPerColor {
+			return fmt.Errorf("%v is on %v stickers: %w", color, counts[color], ErrColorCount)
+		}
+	}
+
+	_, err := s.Distance()
+
+	return err
+}
+
+func (s *Skewb) IsValid() bool {
+	return s.Validate() == nil
+}
+
+type CornersSolvedChecker interface {
+	CornersSolved() bool
+}
+
+// CornersSolved reports whether the eight corners form a solved cube on their own: the four corner stickers
+// of every face share a color and the six faces show six different colors. Unlike IsSolved the centers are
+// not looked at, so a cube with only its centers scrambled, or its corners solved in another orientation
+// than the centers, counts as solved.
+func (s *Skewb) CornersSolved() bool {
+	colors := s.faceletColors()
+	faceColors := map[string]bool{}
+
+	for face := range len(faceletFaces) {
+		corners := faceletOrder[face*5+1 : face*5+5]
+
+		for _, facelet := range corners[1:] {
+			if colors[facelet] != colors[corners[0]] {
+				return false
+			}
+		}
+
+		faceColors[colors[corners[0]]] = true
+	}
+
+	return len(faceColors) == len(faceletFaces)
+}
+
+type CaseKeyer interface {
+	CaseKey() string
+}
+
+// CaseKey identifies the pattern of the cube independently of the color scheme and of the y rotation
+// it is held in, so every scramble that leads to the same last layer case shares the key.
+func (s *Skewb) CaseKey() string {
+	clone := s.clone()
+	key := ""
+
+	for range 4 {
+		clone.ApplyWCAMoves(fmt.Sprintf("%v", Y))
+		relativeColors := getRelativeColors(&clone)
+		candidate := strings.Builder{}
+
+		for _, face := range relativeColors {
+			for _, color := range face {
+				candidate.WriteString(strconv.Itoa(color))
+			}
+		}
+
+		if key == "" || candidate.String() < key {
+			key = candidate.String()
+		}
+	}
+
+	return key
+}
+
+type CornerOrbiter interface {
+	CornerOrbit(name string) int
+	Orbits() ([]string, []string)
+}
+
+// In the solved cube ufr, ubl, dbr and dfl form orbit 0 and urb, ulf, drf and dlb orbit 1, two tetrahedra
+// whose corners no move ever mixes. Quarter turn rotations swap which four positions hold each orbit.
+var cornerOrbits = [8]int{0, 1, 1, 0, 1, 0, 0, 1}
+
+// CornerOrbit returns the orbit of the corner currently at the named position, recognized by its colors,
+// or -1 when the name or the colors match no corner.
+func (s *Skewb) CornerOrbit(name string) int {
+	position := slices.Index(cornerNames, name)
+
+	if position == -1 {
+		return -1
+	}
+
+	piece, _ := cornerPiece(s.facelets[position*3 : position*3+3])
+
+	if piece == -1 {
+		return -1
+	}
+
+	return cornerOrbits[piece]
+}
+
+// Orbits groups the corner positions by the orbit of the corner at each of them.
+func (s *Skewb) Orbits() ([]string, []string) {
+	orbits := [2][]string{}
+
+	for _, name := range cornerNames {
+		if orbit := s.CornerOrbit(name); orbit != -1 {
+			orbits[orbit] = append(orbits[orbit], name)
+		}
+	}
+
+	return orbits[0], orbits[1]
+}
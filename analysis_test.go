@@ -0,0 +1,154 @@
+package skewb
+
+import "testing"
+
+// withCenters returns a solved cube with its centers, in up, front, right, back, left, down order, replaced
+// by the centers of the solved cube with the given indexes.
+func withCenters(centers [6]int) Skewb {
+	s := New("U", "F", "R", "B", "L", "D")
+
+	for i, center := range centers {
+		s.facelets[24+i] = solvedFacelets[24+center]
+	}
+
+	return s
+}
+
+func TestCenterRotationCount(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		centers [6]int
+		count   int
+	}{
+		{name: "solved", centers: [6]int{0, 1, 2, 3, 4, 5}, count: 0},
+		{name: "three cycle", centers: [6]int{1, 2, 0, 3, 4, 5}, count: 3},
+		{name: "two swaps", centers: [6]int{5, 3, 2, 1, 4, 0}, count: 4},
+		{name: "two three cycles", centers: [6]int{1, 2, 0, 4, 5, 3}, count: 6},
+	} {
+		s := withCenters(test.centers)
+
+		if !s.IsValid() {
+			t.Fatalf("%v: the test state is not valid", test.name)
+		}
+
+		if count := s.CenterRotationCount(); count != test.count {
+			t.Errorf("%v: got %v, want %v", test.name, count, test.count)
+		}
+	}
+}
+
+func TestSolveCenterRotations(t *testing.T) {
+	for _, centers := range [][6]int{{0, 1, 2, 3, 4, 5}, {1, 2, 0, 3, 4, 5}, {5, 3, 2, 1, 4, 0}, {1, 2, 0, 4, 5, 3}} {
+		s := withCenters(centers)
+		moves, err := s.SolveCenterRotations()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := s.ApplyWCAMoves(moves); err != nil {
+			t.Fatal(err)
+		}
+
+		if count := s.CenterRotationCount(); count != 0 {
+			t.Errorf("%v: %v centers still rotated after %q", centers, count, moves)
+		}
+
+		if !s.IsSolved() {
+			t.Errorf("%v: %q disturbs the corners", centers, moves)
+		}
+	}
+}
+
+func TestSolvedPieces(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		centers  [6]int
+		solved   int
+		scramble string
+	}{
+		{name: "solved", centers: [6]int{0, 1, 2, 3, 4, 5}, solved: 6},
+		{name: "rotated", centers: [6]int{0, 1, 2, 3, 4, 5}, solved: 6, scramble: "x y'"},
+		{name: "two center swaps", centers: [6]int{5, 3, 2, 1, 4, 0}, solved: 2},
+		{name: "center three cycle", centers: [6]int{1, 2, 0, 3, 4, 5}, solved: 3},
+		{name: "R L R' L'", centers: [6]int{0, 1, 2, 3, 4, 5}, solved: 3, scramble: "R L R' L'"},
+		{name: "rotated R L R' L'", centers: [6]int{0, 1, 2, 3, 4, 5}, solved: 3, scramble: "z y R L R' L'"},
+	} {
+		s := withCenters(test.centers)
+		s.ApplyWCAMoves(test.scramble)
+
+		if solved := s.SolvedCenters(); solved != test.solved || solved != 6-s.CenterRotationCount() {
+			t.Errorf("%v: got %v solved centers, want %v", test.name, solved, test.solved)
+		}
+
+		if s.IsSolved() != (test.solved == 6) {
+			t.Errorf("%v: IsSolved is %v with %v solved centers", test.name, s.IsSolved(), test.solved)
+		}
+
+		if solved := s.SolvedCorners(); test.solved == 6 && solved != 8 {
+			t.Errorf("%v: got %v solved corners, want 8", test.name, solved)
+		}
+	}
+}
+
+func TestDistinctColorCount(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		s     Skewb
+		count int
+	}{
+		{name: "solved", s: New("U", "F", "R", "B", "L", "D"), count: 6},
+		{name: "merged", s: New("U", "F", "R", "B", "L", "U"), count: 5},
+		{name: "single color", s: New("U", "U", "U", "U", "U", "U"), count: 1},
+	} {
+		test.s.ApplyWCAMoves("R U' B")
+
+		if count := test.s.DistinctColorCount(); count != test.count {
+			t.Errorf("%v: got %v, want %v", test.name, count, test.count)
+		}
+	}
+}
+
+func TestSledgehammerSolves(t *testing.T) {
+	for _, test := range []struct {
+		scramble string
+		solves   bool
+	}{
+		{scramble: "L R' L' R", solves: true},
+		{scramble: "y L R' L' R y'", solves: true},
+		{scramble: "", solves: false},
+		{scramble: "R U' B L'", solves: false},
+	} {
+		s := New("U", "F", "R", "B", "L", "D")
+		s.ApplyWCAMoves(test.scramble)
+
+		if solves := s.SledgehammerSolves(); solves != test.solves {
+			t.Errorf("%q: got %v, want %v", test.scramble, solves, test.solves)
+		}
+	}
+}
+
+func TestCaseKey(t *testing.T) {
+	key := func(s Skewb, scramble string) string {
+		s.ApplyWCAMoves(scramble)
+
+		return s.CaseKey()
+	}
+	sledgehammer := key(New("U", "F", "R", "B", "L", "D"), "L R' L' R")
+
+	for _, test := range []struct {
+		s        Skewb
+		scramble string
+		same     bool
+	}{
+		{s: New("U", "F", "R", "B", "L", "D"), scramble: "L R' L' R R R R", same: true},
+		{s: New("U", "F", "R", "B", "L", "D"), scramble: "L R' L' R y", same: true},
+		{s: NewFromScheme(DefaultScheme()), scramble: "L R' L' R y2", same: true},
+		{s: New("U", "F", "R", "B", "L", "D"), scramble: "R U'", same: false},
+		{s: New("U", "F", "R", "B", "L", "D"), scramble: "", same: false},
+	} {
+		if same := key(test.s, test.scramble) == sledgehammer; same != test.same {
+			t.Errorf("%q: shares the key %v, want %v", test.scramble, same, test.same)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package skewb
+
+import (
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+)
+
+type BinaryCoder interface {
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
+
+type GobCoder interface {
+	gob.GobEncoder
+	gob.GobDecoder
+}
+
+var (
+	ErrBinary = errors.New("data is not a binary encoded skewb")
+
+	// coordBytes holds the 38 bits of the largest Coord, maxCoord = 8!*3^8*6! being one past it.
+	coordBytes = 5
+)
+
+// MarshalBinary writes the six colors in the order Coord numbers them, up to down of the cube the Coord
+// decodes to, each as its length in a uvarint followed by its bytes, and then the Coord of the state in 5 big
+// endian bytes. The history is not encoded. States without a Coord return an error wrapping ErrBinary.
+func (s *Skewb) MarshalBinary() ([]byte, error) {
+	relative, err := s.relativeFacelets()
+	coord := s.Coord()
+
+	if err != nil || coord == InvalidCoord {
+		return nil, ErrBinary
+	}
+
+	colors := [6]string{}
+
+	for i, code := range relative {
+		colors[code] = s.color(i)
+	}
+
+	data := []byte{}
+
+	for _, color := range colors {
+		data = binary.AppendUvarint(data, uint64(len(color)))
+		data = append(data, color...)
+	}
+
+	coordData := binary.BigEndian.AppendUint64(nil, uint64(coord))
+
+	return append(data, coordData[8-coordBytes:]...), nil
+}
+
+// UnmarshalBinary replaces the cube with the one MarshalBinary encoded, with an empty history.
+func (s *Skewb) UnmarshalBinary(data []byte) error {
+	colors := make([]string, len(centerNames))
+
+	for i := range colors {
+		length, n := binary.Uvarint(data)
+
+		if n <= 0 || uint64(len(data)-n) < length {
+			return ErrBinary
+		}
+
+		colors[i] = string(data[n : n+int(length)])
+		data = data[n+int(length):]
+	}
+
+	if len(data) != coordBytes {
+		return ErrBinary
+	}
+
+	coord := Coord(binary.BigEndian.Uint64(append(make([]byte, 8-coordBytes), data...)))
+
+	decoded, err := coord.Skewb()
+
+	if err != nil {
+		return ErrBinary
+	}
+
+	*s = decoded
+	s.colors = colors
+
+	return nil
+}
+
+// GobEncode is MarshalBinary, so cubes sent over encoding/gob use the same compact encoding.
+func (s *Skewb) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+func (s *Skewb) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
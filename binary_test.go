@@ -0,0 +1,44 @@
+package skewb
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	for _, moves := range []string{"", "x y", scramble} {
+		s := NewFromScheme(DefaultScheme())
+		s.ApplyWCAMoves(moves)
+		set := Skewb{}
+		set.SetState(s.State())
+
+		for name, cube := range map[string]*Skewb{"new": &s, "set state": &set} {
+			data, err := cube.MarshalBinary()
+
+			if err != nil {
+				t.Fatalf("%v %q: %v", name, moves, err)
+			}
+
+			decoded := Skewb{}
+
+			if err := decoded.UnmarshalBinary(data); err != nil {
+				t.Fatalf("%v %q: %v", name, moves, err)
+			}
+
+			if !decoded.ExactEqual(cube) {
+				t.Errorf("%v %q: the decoded cube has other colors", name, moves)
+			}
+		}
+	}
+
+	s := New("U", "F", "R", "B", "L", "D")
+	s.SetUpCenterColor("F")
+
+	if _, err := s.MarshalBinary(); !errors.Is(err, ErrBinary) {
+		t.Errorf("got %v for two front colored centers, want ErrBinary", err)
+	}
+
+	if err := s.UnmarshalBinary([]byte{1, 'U'}); !errors.Is(err, ErrBinary) {
+		t.Errorf("got %v for truncated data, want ErrBinary", err)
+	}
+}
@@ -0,0 +1,160 @@
+package skewb
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+type Coorder interface {
+	Coord() Coord
+}
+
+// Coord packs a state into one integer. Colors are numbered the way the solver numbers them, from the
+// colors of the ufr corner as up 0, front 1 and right 2 and the center colors opposite them as down 5, back 3
+// and left 4, so a Coord does not depend on the color scheme, and a cube recolored by another scheme or a
+// whole cube rotation has the Coord of the cube it recolors. Every corner position holds one of the eight
+// corners of the solved cube, identified by its numbers, twisted by the position (0, 1 or 2) its up or down
+// sticker moved to, and every center position holds one of the six numbers:
+//
+//	Coord = (cornerPermutation*3^8 + cornerTwists)*6! + centerPermutation
+//
+// where the permutations are Lehmer ranks (below 8! and 6!) and cornerTwists reads the eight twists as a
+// base 3 number, ufr first. The largest value, 8!*3^8*6!, needs 38 bits.
+type Coord uint64
+
+var (
+	ErrCoord = errors.New("coord is not below 8!*3^8*6!")
+
+	// InvalidCoord is returned for states whose corners or centers are not a permutation of the solved ones.
+	InvalidCoord = Coord(math.MaxUint64)
+
+	cornerTwistStates  = 6561 // 3^8
+	centerPermutations = 720  // 6!
+	maxCoord           = Coord(40320 * 6561 * 720)
+)
+
+// Coord returns the Coord of the state, or InvalidCoord when its colors can not be numbered like a Skewb's.
+func (s *Skewb) Coord() Coord {
+	relative, err := s.relativeFacelets()
+
+	if err != nil {
+		return InvalidCoord
+	}
+
+	corners, twists, centers := make([]int, 8), 0, make([]int, 6)
+
+	for position := range corners {
+		piece, twist := cornerPiece(relative[position*3 : position*3+3])
+
+		if piece == -1 {
+			return InvalidCoord
+		}
+
+		corners[position] = piece
+		twists = twists*3 + twist
+	}
+
+	for position := range centers {
+		centers[position] = int(relative[24+position])
+	}
+
+	cornerRank, centerRank := rankPermutation(corners), rankPermutation(centers)
+
+	if cornerRank == -1 || centerRank == -1 {
+		return InvalidCoord
+	}
+
+	return Coord((cornerRank*cornerTwistStates+twists)*centerPermutations + centerRank)
+}
+
+// Skewb decodes the state in the U, F, R, B, L, D color scheme used by the solver. A value from 8!*3^8*6! on,
+// such as InvalidCoord, returns an error wrapping ErrCoord.
+func (c Coord) Skewb() (Skewb, error) {
+	if c >= maxCoord {
+		return Skewb{}, fmt.Errorf("%v %w", uint64(c), ErrCoord)
+	}
+
+	value := int(c)
+	centers := unrankPermutation(value%centerPermutations, 6)
+	value /= centerPermutations
+	twists := value % cornerTwistStates
+	corners := unrankPermutation(value/cornerTwistStates, 8)
+	s := New("U", "F", "R", "B", "L", "D")
+
+	for position := 7; position >= 0; position-- {
+		twist := twists % 3
+		twists /= 3
+
+		for i := range 3 {
+			s.facelets[position*3+(i+twist)%3] = solvedFacelets[corners[position]*3+i]
+		}
+	}
+
+	for position, center := range centers {
+		s.facelets[24+position] = int8(center)
+	}
+
+	return s, nil
+}
+
+func cornerPiece(colors []int8) (int, int) {
+	for piece := range 8 {
+		solved := solvedFacelets[piece*3 : piece*3+3]
+
+		for twist := range 3 {
+			if colors[twist] == solved[0] && colors[(twist+1)%3] == solved[1] && colors[(twist+2)%3] == solved[2] {
+				return piece, twist
+			}
+		}
+	}
+
+	return -1, 0
+}
+
+func rankPermutation(permutation []int) int {
+	rank := 0
+	used := make([]bool, len(permutation))
+
+	for i, value := range permutation {
+		if value < 0 || value >= len(permutation) || used[value] {
+			return -1
+		}
+
+		smaller := 0
+
+		for j := range value {
+			if !used[j] {
+				smaller++
+			}
+		}
+
+		used[value] = true
+		rank = rank*(len(permutation)-i) + smaller
+	}
+
+	return rank
+}
+
+func unrankPermutation(rank, n int) []int {
+	digits := make([]int, n)
+
+	for i := n - 1; i >= 0; i-- {
+		digits[i] = rank % (n - i)
+		rank /= n - i
+	}
+
+	permutation := make([]int, n)
+	unused := make([]int, n)
+
+	for i := range unused {
+		unused[i] = i
+	}
+
+	for i, digit := range digits {
+		permutation[i] = unused[digit]
+		unused = append(unused[:digit], unused[digit+1:]...)
+	}
+
+	return permutation
+}
@@ -0,0 +1,49 @@
+package skewb
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCoord(t *testing.T) {
+	for _, moves := range []string{"", "y", scramble} {
+		s := New("U", "F", "R", "B", "L", "D")
+		s.ApplyWCAMoves(moves)
+		other := NewFromScheme(DefaultScheme())
+		other.ApplyWCAMoves(moves)
+		set := Skewb{}
+		set.SetState(other.State())
+		coord := s.Coord()
+
+		if coord == InvalidCoord {
+			t.Fatalf("%q: got InvalidCoord", moves)
+		}
+
+		if got := set.Coord(); got != coord {
+			t.Errorf("%q: got %v for the cube set from another scheme, want %v", moves, got, coord)
+		}
+
+		decoded, err := coord.Skewb()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !decoded.PatternEqual(&s) || decoded.Coord() != coord {
+			t.Errorf("%q: %v does not decode to the pattern of the cube", moves, coord)
+		}
+	}
+
+	s := New("U", "F", "R", "B", "L", "D")
+	s.SetUpCenterColor("F")
+
+	if coord := s.Coord(); coord != InvalidCoord {
+		t.Errorf("got %v for two front colored centers, want InvalidCoord", coord)
+	}
+
+	for _, coord := range []Coord{maxCoord, InvalidCoord} {
+		if _, err := coord.Skewb(); !errors.Is(err, ErrCoord) {
+			t.Errorf("%v: got %v, want ErrCoord", uint64(coord), err)
+		}
+	}
+}
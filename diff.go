@@ -0,0 +1,59 @@
+package skewb
+
+import (
+	"fmt"
+	"slices"
+)
+
+type Differ interface {
+	Diff(other Skewber) Difference
+}
+
+type Difference struct {
+	Corners []string
+	Centers []string
+}
+
+// Diff lists the pieces that differ from other in the orientation with the fewest differences. Like Equal
+// it puts the matching down center down and tries the three y rotations from there, on a copy of a *Skewb.
+func (s *Skewb) Diff(other Skewber) Difference {
+	other = reorientable(other)
+
+	if err := other.CenterDown(s.GetDownCenterColor()); err != nil {
+		return Difference{Corners: slices.Clone(cornerNames), Centers: slices.Clone(centerNames)}
+	}
+
+	best := s.diff(other)
+
+	for range 3 {
+		other.ApplyWCAMoves(fmt.Sprintf("%v", Y))
+
+		if difference := s.diff(other); len(difference.Corners)+len(difference.Centers) < len(best.Corners)+len(best.Centers) {
+			best = difference
+		}
+	}
+
+	return best
+}
+
+func (s *Skewb) diff(other Skewber) Difference {
+	difference := Difference{Corners: []string{}, Centers: []string{}}
+	sCorners := [8][3]string{s.GetUFRCornerColors(), s.GetURBCornerColors(), s.GetULFCornerColors(), s.GetUBLCornerColors(), s.GetDRFCornerColors(), s.GetDBRCornerColors(), s.GetDFLCornerColors(), s.GetDLBCornerColors()}
+	otherCorners := [8][3]string{other.GetUFRCornerColors(), other.GetURBCornerColors(), other.GetULFCornerColors(), other.GetUBLCornerColors(), other.GetDRFCornerColors(), other.GetDBRCornerColors(), other.GetDFLCornerColors(), other.GetDLBCornerColors()}
+	sCenters := [6]string{s.GetUpCenterColor(), s.GetFrontCenterColor(), s.GetRightCenterColor(), s.GetBackCenterColor(), s.GetLeftCenterColor(), s.GetDownCenterColor()}
+	otherCenters := [6]string{other.GetUpCenterColor(), other.GetFrontCenterColor(), other.GetRightCenterColor(), other.GetBackCenterColor(), other.GetLeftCenterColor(), other.GetDownCenterColor()}
+
+	for i := range sCorners {
+		if sCorners[i] != otherCorners[i] {
+			difference.Corners = append(difference.Corners, cornerNames[i])
+		}
+	}
+
+	for i := range sCenters {
+		if sCenters[i] != otherCenters[i] {
+			difference.Centers = append(difference.Centers, centerNames[i])
+		}
+	}
+
+	return difference
+}
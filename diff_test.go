@@ -0,0 +1,48 @@
+package skewb
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	for _, test := range []struct {
+		scramble string
+		corners  []string
+		centers  []string
+	}{
+		{scramble: "", corners: []string{}, centers: []string{}},
+		{scramble: "x y", corners: []string{}, centers: []string{}},
+		{scramble: "U", corners: []string{"urb", "ulf", "ubl", "dlb"}, centers: []string{"up", "back", "left"}},
+	} {
+		s := New("U", "F", "R", "B", "L", "D")
+		other := New("U", "F", "R", "B", "L", "D")
+		other.ApplyWCAMoves(test.scramble)
+		before := other.clone()
+		difference := s.Diff(&other)
+
+		if !slices.Equal(difference.Corners, test.corners) || !slices.Equal(difference.Centers, test.centers) {
+			t.Errorf("%q: got %v, want %v %v", test.scramble, difference, test.corners, test.centers)
+		}
+
+		if !other.ExactEqual(&before) {
+			t.Errorf("%q: Diff rotated the other cube", test.scramble)
+		}
+	}
+}
+
+func TestDiffFailure(t *testing.T) {
+	s := New("U", "F", "R", "B", "L", "D")
+	other := New("1", "2", "3", "4", "5", "6")
+	difference := s.Diff(&other)
+
+	if len(difference.Corners) != 8 || len(difference.Centers) != 6 {
+		t.Fatalf("got %v, want every piece", difference)
+	}
+
+	difference.Corners[0], difference.Centers[0] = "changed", "changed"
+
+	if again := s.Diff(&other); again.Corners[0] != "ufr" || again.Centers[0] != "up" {
+		t.Errorf("got %v after changing an earlier Difference", again)
+	}
+}
@@ -0,0 +1,67 @@
+// Package draw rasterizes skewb nets with the canvas package. Importing it registers Render as the renderer
+// of the skewb drawing methods, which return skewb.ErrNoRenderer without it:
+//
+//	import _ "github.com/Rasek91/skewb/draw"
+package draw
+
+import (
+	"image"
+
+	"github.com/Rasek91/skewb"
+	"github.com/tfriedel6/canvas"
+	"github.com/tfriedel6/canvas/backend/softwarebackend"
+)
+
+func init() {
+	skewb.RegisterRenderer(Render)
+}
+
+// Render fills and outlines the stickers in order on a width by height image, on the background of style
+// if it has one.
+func Render(stickers []skewb.Sticker, width, height int, style skewb.DrawStyle) *image.RGBA {
+	cv, image := newCanvas(width, height, style)
+
+	for _, sticker := range stickers {
+		drawSticker(cv, sticker)
+	}
+
+	return image
+}
+
+func newCanvas(width, height int, style skewb.DrawStyle) (*canvas.Canvas, *image.RGBA) {
+	backend := softwarebackend.New(width, height)
+	cv := canvas.New(backend)
+	image := cv.GetImageData(0, 0, width, height)
+
+	if style.Background != nil {
+		cv.SetFillStyle(style.Background)
+		cv.FillRect(0, 0, float64(width), float64(height))
+	}
+
+	if style.HideStrokes {
+		cv.SetStrokeStyle("#00000000")
+	} else {
+		cv.SetStrokeStyle(style.StrokeColor)
+	}
+
+	cv.SetLineWidth(style.StrokeWidth)
+	cv.SetLineJoin(canvas.Round)
+	cv.SetLineCap(canvas.Round)
+
+	return cv, image
+}
+
+func drawSticker(cv *canvas.Canvas, sticker skewb.Sticker) {
+	cv.SetFillStyle(sticker.Color)
+	cv.BeginPath()
+	cv.MoveTo(sticker.Vertices[0][0], sticker.Vertices[0][1])
+
+	for _, vertex := range sticker.Vertices[1:] {
+		cv.LineTo(vertex[0], vertex[1])
+	}
+
+	cv.LineTo(sticker.Vertices[0][0], sticker.Vertices[0][1])
+	cv.ClosePath()
+	cv.Fill()
+	cv.Stroke()
+}
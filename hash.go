@@ -0,0 +1,85 @@
+package skewb
+
+import (
+	"hash/fnv"
+	"strings"
+)
+
+type Hasher interface {
+	Hash() uint64
+	CanonicalHash() uint64
+}
+
+type Keyer interface {
+	Key() StateKey
+}
+
+// StateKey is comparable with == and usable as a map key. Colors lists the colors of the cube in the order
+// they first appear on the facelets, followed by empty strings, and Facelets holds the index into that list
+// of the color of every facelet.
+type StateKey struct {
+	Colors   [30]string
+	Facelets [30]byte
+}
+
+// Key is the fast path for ExactEqual: two cubes have equal Keys exactly when they are ExactEqual, whatever
+// color scheme they were made with. It reads the facelets directly and does not allocate.
+func (s *Skewb) Key() StateKey {
+	key := StateKey{}
+	// keyIndexes holds, for every palette index already seen, one more than its index into key.Colors.
+	keyIndexes := [maxColors]byte{}
+	count := byte(0)
+
+	for i, paletteIndex := range s.facelets {
+		if keyIndexes[paletteIndex] == 0 {
+			color := s.color(i)
+			index := byte(0)
+
+			for index < count && key.Colors[index] != color {
+				index++
+			}
+
+			if index == count {
+				key.Colors[count] = color
+				count++
+			}
+
+			keyIndexes[paletteIndex] = index + 1
+		}
+
+		key.Facelets[i] = keyIndexes[paletteIndex] - 1
+	}
+
+	return key
+}
+
+// Hash is orientation sensitive: states that are ExactEqual hash equally.
+func (s *Skewb) Hash() uint64 {
+	hash := fnv.New64a()
+	hash.Write([]byte(s.faceletString()))
+
+	return hash.Sum64()
+}
+
+// CanonicalHash hashes the orientation with the smallest facelet string out of all 24, so states that
+// are Equal hash equally.
+func (s *Skewb) CanonicalHash() uint64 {
+	canonical := ""
+
+	for _, orientation := range s.Orientations() {
+		if faceletString := orientation.faceletString(); canonical == "" || faceletString < canonical {
+			canonical = faceletString
+		}
+	}
+
+	hash := fnv.New64a()
+	hash.Write([]byte(canonical))
+
+	return hash.Sum64()
+}
+
+func (s *Skewb) faceletString() string {
+	colors := s.faceletColors()
+
+	return strings.Join(colors[:], "\x00")
+}
@@ -0,0 +1,57 @@
+package skewb
+
+import "testing"
+
+func TestKey(t *testing.T) {
+	newCube := func(colors [6]string, moves string) Skewb {
+		s := New(colors[0], colors[1], colors[2], colors[3], colors[4], colors[5])
+		s.ApplyWCAMoves(moves)
+
+		return s
+	}
+	standard := [6]string{"U", "F", "R", "B", "L", "D"}
+	flipped := [6]string{"D", "B", "R", "F", "L", "U"}
+	scrambled, set := newCube(standard, scramble), Skewb{}
+	set.SetState(scrambled.State())
+
+	for _, test := range []struct {
+		name          string
+		first, second Skewb
+		equal         bool
+	}{
+		{name: "same state", first: newCube(standard, scramble), second: newCube(standard, scramble), equal: true},
+		{name: "different state", first: newCube(standard, "R"), second: newCube(standard, "R'"), equal: false},
+		{name: "rotated", first: newCube(standard, ""), second: newCube(standard, "x"), equal: false},
+		{name: "other scheme", first: newCube(standard, ""), second: newCube(flipped, ""), equal: false},
+		{name: "same colors from another scheme", first: newCube(standard, "x2"), second: newCube(flipped, ""), equal: true},
+		{name: "set state", first: newCube(standard, scramble), second: set, equal: true},
+	} {
+		if test.first.ExactEqual(&test.second) != test.equal {
+			t.Fatalf("%v: ExactEqual is not %v", test.name, test.equal)
+		}
+
+		if equal := test.first.Key() == test.second.Key(); equal != test.equal {
+			t.Errorf("%v: equal keys is %v, want %v", test.name, equal, test.equal)
+		}
+	}
+
+	seen := map[StateKey]bool{}
+
+	for _, moves := range []string{"", "R", "R R R", "R'", "R R"} {
+		s := newCube(standard, moves)
+		seen[s.Key()] = true
+	}
+
+	if len(seen) != 3 {
+		t.Errorf("got %v distinct keys, want 3", len(seen))
+	}
+}
+
+func TestKeyAllocations(t *testing.T) {
+	s := New("U", "F", "R", "B", "L", "D")
+	s.ApplyWCAMoves(scramble)
+
+	if allocations := testing.AllocsPerRun(100, func() { s.Key() }); allocations != 0 {
+		t.Errorf("got %v allocations, want 0", allocations)
+	}
+}
@@ -0,0 +1,186 @@
+package skewb
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type HistoryKeeper interface {
+	Undo() error
+	Redo() error
+	History() []Move
+}
+
+type StatsKeeper interface {
+	Stats() MoveStats
+	ResetStats()
+}
+
+// MoveStats counts the moves applied through ApplyWCAMoves and ApplyRubiskewbMoves, and through the methods
+// built on them. Undo and Redo are not counted.
+type MoveStats struct {
+	Total     int
+	FaceTurns int
+	Rotations int
+}
+
+type Tracer interface {
+	Trace(moves string) ([]Skewb, error)
+}
+
+type appliedMove struct {
+	move        Move
+	isRubiskewb bool
+}
+
+var (
+	ErrEmptyHistory = errors.New("there is no move to undo")
+	ErrEmptyRedo    = errors.New("there is no move to redo")
+
+	// historyLimit bounds the history of a cube that is turned for a long time, like one reused in a loop.
+	historyLimit = 1000
+)
+
+// Undo reverts the most recently applied move. Every token applied through ApplyWCAMoves or
+// ApplyRubiskewbMoves is one history entry, whole cube rotations (x, y, z and their variants) included, so
+// Undo after "R x" reverts the x rotation and a second Undo reverts the R turn. Only the last 1000 moves
+// are kept. Equal and the other comparisons reorient copies, so they neither add to the history nor clear
+// the redo stack.
+func (s *Skewb) Undo() error {
+	if len(s.history) == 0 {
+		return ErrEmptyHistory
+	}
+
+	last := s.history[len(s.history)-1]
+
+	if err := s.applyNotationMove(inverseMove(last.move), last.isRubiskewb); err != nil {
+		return err
+	}
+
+	s.history = s.history[:len(s.history)-1]
+	s.undone = append(s.undone, last)
+
+	return nil
+}
+
+// Redo reapplies the most recently undone move. The redo stack is cleared as soon as any new move is
+// applied, so History always lists the moves that produced the current state.
+func (s *Skewb) Redo() error {
+	if len(s.undone) == 0 {
+		return ErrEmptyRedo
+	}
+
+	last := s.undone[len(s.undone)-1]
+
+	if err := s.applyNotationMove(last.move, last.isRubiskewb); err != nil {
+		return err
+	}
+
+	s.undone = s.undone[:len(s.undone)-1]
+	s.history = append(s.history, last)
+
+	return nil
+}
+
+func (s *Skewb) History() []Move {
+	moves := make([]Move, len(s.history))
+
+	for i, applied := range s.history {
+		moves[i] = applied.move
+	}
+
+	return moves
+}
+
+func (s *Skewb) record(applied appliedMove) {
+	if len(s.history) == historyLimit {
+		s.history = append(s.history[:0], s.history[1:]...)
+	}
+
+	s.history = append(s.history, applied)
+	s.undone = nil
+	s.stats.Total++
+
+	if isRotation(applied.move) {
+		s.stats.Rotations++
+	} else {
+		s.stats.FaceTurns++
+	}
+}
+
+// Stats returns the moves applied since the cube was created or ResetStats was last called, including the
+// rotations of CenterDown.
+func (s *Skewb) Stats() MoveStats {
+	return s.stats
+}
+
+func (s *Skewb) ResetStats() {
+	s.stats = MoveStats{}
+}
+
+func (s *Skewb) applyNotationMove(move Move, isRubiskewb bool) error {
+	permutations, errMove := wcaMovePermutations, ErrWCAMove
+
+	if isRubiskewb {
+		permutations, errMove = rubiskewbMovePermutations, ErrRubiskewbMove
+	}
+
+	permutation, ok := permutations[move]
+
+	if !ok {
+		return fmt.Errorf("%v %w", move, errMove)
+	}
+
+	s.facelets = s.facelets.permute(permutation)
+
+	return nil
+}
+
+func inverseMove(move Move) Move {
+	switch {
+	case strings.HasSuffix(string(move), "2") && isRotation(move):
+		return move
+	case strings.HasSuffix(string(move), "2"):
+		return Move(strings.TrimSuffix(string(move), "2"))
+	case strings.HasSuffix(string(move), "'"):
+		return Move(strings.TrimSuffix(string(move), "'"))
+	default:
+		return move + "'"
+	}
+}
+
+// ReconstructStart undoes solution, given in WCA notation, on a copy of end and returns the state the
+// solution started from.
+func ReconstructStart(end Skewb, solution string) (Skewb, error) {
+	start := end.clone()
+	moves, err := parseNotationMoves(solution, wcaMovePermutations, ErrWCAMove)
+
+	if err != nil {
+		return Skewb{}, err
+	}
+
+	for _, move := range slices.Backward(moves) {
+		start.facelets = start.facelets.permute(wcaMovePermutations[inverseMove(move)])
+	}
+
+	return start, nil
+}
+
+// Trace applies the WCA moves to a copy of the cube and returns the state before the first move and after
+// every move. An invalid move is reported with its index in the sequence.
+func (s *Skewb) Trace(moves string) ([]Skewb, error) {
+	state := s.clone()
+	states := []Skewb{state.clone()}
+
+	for i, move := range splitMoves(moves) {
+		if err := state.ApplyWCAMoves(move); err != nil {
+			return nil, fmt.Errorf("move %v: %w", i, err)
+		}
+
+		states = append(states, state.clone())
+	}
+
+	return states, nil
+}
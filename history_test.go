@@ -0,0 +1,149 @@
+package skewb
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestUndoRedo(t *testing.T) {
+	for _, moves := range []string{"R", "R U' B L'", "R x U2 y' L", "x2' R"} {
+		s := New("U", "F", "R", "B", "L", "D")
+		solved := New("U", "F", "R", "B", "L", "D")
+
+		if err := s.ApplyWCAMoves(moves); err != nil {
+			t.Fatal(err)
+		}
+
+		scrambled := s.clone()
+		history := s.History()
+
+		for range history {
+			if err := s.Undo(); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if !s.ExactEqual(&solved) {
+			t.Errorf("%q: undoing every move does not give solved", moves)
+		}
+
+		if err := s.Undo(); !errors.Is(err, ErrEmptyHistory) {
+			t.Errorf("%q: got %v undoing an empty history, want ErrEmptyHistory", moves, err)
+		}
+
+		for range history {
+			if err := s.Redo(); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if !s.ExactEqual(&scrambled) || !slices.Equal(s.History(), history) {
+			t.Errorf("%q: redoing every move does not give the scramble back", moves)
+		}
+
+		if err := s.Redo(); !errors.Is(err, ErrEmptyRedo) {
+			t.Errorf("%q: got %v redoing an empty stack, want ErrEmptyRedo", moves, err)
+		}
+	}
+}
+
+func TestUndoRubiskewb(t *testing.T) {
+	s := New("U", "F", "R", "B", "L", "D")
+	solved := New("U", "F", "R", "B", "L", "D")
+
+	if err := s.ApplyRubiskewbMoves("F r' l2 b"); err != nil {
+		t.Fatal(err)
+	}
+
+	for range 4 {
+		if err := s.Undo(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if !s.ExactEqual(&solved) {
+		t.Error("undoing Rubiskewb moves does not give solved")
+	}
+}
+
+func TestApplyClearsRedo(t *testing.T) {
+	s := New("U", "F", "R", "B", "L", "D")
+	s.ApplyWCAMoves("R U")
+	s.Undo()
+	s.ApplyWCAMoves("L")
+
+	if err := s.Redo(); !errors.Is(err, ErrEmptyRedo) {
+		t.Errorf("got %v, want ErrEmptyRedo after a new move", err)
+	}
+
+	if history := s.History(); !slices.Equal(history, []Move{R, L}) {
+		t.Errorf("got history %v, want [R L]", history)
+	}
+}
+
+func TestComparisonsKeepHistory(t *testing.T) {
+	s := New("U", "F", "R", "B", "L", "D")
+	other := New("U", "F", "R", "B", "L", "D")
+	s.ApplyWCAMoves("R U'")
+	other.ApplyWCAMoves("R U' x y")
+	other.Undo()
+
+	s.Equal(&other)
+	s.FullMirror(&other)
+	s.OneLayerMirror(&other, "D")
+	s.Diff(&other)
+	s.AlignTo(&other)
+
+	if history := other.History(); !slices.Equal(history, []Move{R, UPrime, X}) {
+		t.Errorf("got history %v, want [R U' x]", history)
+	}
+
+	if history := s.History(); !slices.Equal(history, []Move{R, UPrime}) {
+		t.Errorf("got history %v, want [R U']", history)
+	}
+
+	if err := other.Redo(); err != nil {
+		t.Errorf("got %v, want the undone y to be redone", err)
+	}
+}
+
+func TestHistoryLimit(t *testing.T) {
+	s := New("U", "F", "R", "B", "L", "D")
+
+	for range historyLimit + 10 {
+		s.ApplyWCAMoves("R")
+	}
+
+	if length := len(s.History()); length != historyLimit {
+		t.Errorf("got %v history entries, want %v", length, historyLimit)
+	}
+}
+
+func TestReconstructStart(t *testing.T) {
+	for _, test := range []struct {
+		scramble, solution string
+	}{
+		{scramble: "R U' B", solution: "B' U R'"},
+		{scramble: "x R L'", solution: "L R' x'"},
+		{scramble: "R U' B L'", solution: "L B' U R'"},
+	} {
+		start := New("U", "F", "R", "B", "L", "D")
+		start.ApplyWCAMoves(test.scramble)
+		end := start.clone()
+
+		if err := end.ApplyWCAMoves(test.solution); err != nil {
+			t.Fatal(err)
+		}
+
+		reconstructed, err := ReconstructStart(end, test.solution)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reconstructed.ExactEqual(&start) {
+			t.Errorf("%q: ReconstructStart does not give the scrambled state", test.scramble)
+		}
+	}
+}
@@ -0,0 +1,250 @@
+package skewb
+
+import (
+	"errors"
+	"fmt"
+)
+
+type LayerSolver interface {
+	SolveLayer() (string, error)
+	SolveLayered() ([]Move, error)
+	LayerByLayerLength() (int, error)
+}
+
+type LayerChecker interface {
+	IsLayerSolved(color string) bool
+}
+
+type RecognitionHinter interface {
+	RecognitionHint() (string, error)
+}
+
+var (
+	ErrFirstLayer = errors.New("down layer is not solved")
+
+	layerFacelets = []int{12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 29}
+	numberWords   = []string{"no", "one", "two", "three", "four", "five"}
+)
+
+// SolveLayer solves the layer opposite to the first sticker of the ufr corner, the corner WCA face turns
+// never move, so the solved layer is the down layer once the ufr corner is in place.
+func (s *Skewb) SolveLayer() (string, error) {
+	start, err := s.relativeFacelets()
+
+	if err != nil {
+		return "", err
+	}
+
+	solution, err := solveLayerFacelets(start)
+
+	if err != nil {
+		return "", err
+	}
+
+	return joinMoves(solution), nil
+}
+
+func (s *Skewb) LayerByLayerLength() (int, error) {
+	solution, err := s.SolveLayered()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return len(solution), nil
+}
+
+// SolveLayered solves the first layer the way SolveLayer does and then finishes the last layer with the
+// shortest sequence from there, so the solution splits into the two steps of a layer by layer method.
+func (s *Skewb) SolveLayered() ([]Move, error) {
+	start, err := s.relativeFacelets()
+
+	if err != nil {
+		return nil, err
+	}
+
+	layer, err := solveLayerFacelets(start)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, move := range layer {
+		start = start.apply(move)
+	}
+
+	lastLayer, err := tableSolveFacelets(start)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append(layer, lastLayer...), nil
+}
+
+func solveLayerFacelets(start facelets) ([]Move, error) {
+	initSolver()
+
+	path := make([]Move, 0, godsNumber)
+
+	for limit := 0; limit <= godsNumber; limit++ {
+		if solution, found := searchLayer(start, limit, path); found {
+			return solution, nil
+		}
+	}
+
+	return nil, ErrUnsolvable
+}
+
+func searchLayer(state facelets, limit int, path []Move) ([]Move, bool) {
+	if isLayerSolved(state) {
+		return path, true
+	}
+
+	if len(path) == limit {
+		return nil, false
+	}
+
+	for _, move := range WCAFaceMoves {
+		if len(path) > 0 && sameAxis(path[len(path)-1], move) {
+			continue
+		}
+
+		if solution, found := searchLayer(state.apply(move), limit, append(path, move)); found {
+			return solution, true
+		}
+	}
+
+	return nil, false
+}
+
+func isLayerSolved(state facelets) bool {
+	for _, i := range layerFacelets {
+		if state[i] != solvedFacelets[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AllLastLayerCases returns one cube, in the U, F, R, B, L, D color scheme used by the solver, for every
+// state with the D colored layer solved and held down, keyed by CaseKey so cases that only differ by a y
+// rotation of the top layer share an entry.
+func AllLastLayerCases() map[string]Skewb {
+	initTable()
+
+	cases := map[string]Skewb{}
+	downCode := solvedFacelets[24+downCenter]
+
+	for _, corners := range cornerStates {
+		for _, centers := range centerStates {
+			state := facelets{}
+			copy(state[:24], corners[:])
+			copy(state[24:], centers[:])
+
+			for _, rotation := range upRotations {
+				rotated := state
+
+				if rotation != "" {
+					rotated = state.permute(rotationPermutations[Move(rotation)])
+				}
+
+				if rotated[24+downCenter] != downCode || !isDownLayerSolved(rotated) {
+					continue
+				}
+
+				s := New("U", "F", "R", "B", "L", "D")
+				s.facelets = rotated
+				key := s.CaseKey()
+
+				if _, ok := cases[key]; !ok {
+					cases[key] = s
+				}
+			}
+		}
+	}
+
+	return cases
+}
+
+// isDownLayerSolved only compares the stickers of the down layer with each other, so it does not depend on
+// which color is down or on the cube being held with the ufr corner in place.
+func isDownLayerSolved(state facelets) bool {
+	down := state[24+downCenter]
+
+	return state[12] == down && state[15] == down && state[18] == down && state[21] == down &&
+		state[14] == state[19] && state[13] == state[17] && state[16] == state[23] && state[20] == state[22]
+}
+
+// IsLayerSolved reports whether the layer of the center with the given color is solved: its four corners show
+// the color on that face and their side stickers match each other. As in SolveLayer the side centers are
+// not part of the layer, they are solved with the last layer. A color that is not a center is never solved.
+func (s *Skewb) IsLayerSolved(color string) bool {
+	clone := s.clone()
+
+	if err := clone.CenterDown(color); err != nil {
+		return false
+	}
+
+	return isDownLayerSolved(clone.facelets)
+}
+
+// RecognitionHint describes a last layer case by how many top corners show the top color, and whether two
+// of them are adjacent or opposite, followed by how many of the five last layer centers are out of place.
+func (s *Skewb) RecognitionHint() (string, error) {
+	if !isDownLayerSolved(s.facelets) {
+		return "", ErrFirstLayer
+	}
+
+	drf, dbr, dfl := s.GetDRFCornerColors(), s.GetDBRCornerColors(), s.GetDFLCornerColors()
+	upColor, err := s.oppositeColor(s.GetDownCenterColor())
+
+	if err != nil {
+		return "", err
+	}
+
+	// Top corners in the order they go around the up face, so neighbours in the slice are adjacent.
+	topCorners := [4][3]string{s.GetUFRCornerColors(), s.GetURBCornerColors(), s.GetUBLCornerColors(), s.GetULFCornerColors()}
+	oriented := []int{}
+
+	for i, colors := range topCorners {
+		if colors[0] == upColor {
+			oriented = append(oriented, i)
+		}
+	}
+
+	pattern := fmt.Sprintf("%v %v on top", numberWords[len(oriented)], upColor)
+
+	switch {
+	case len(oriented) == 2 && oriented[1]-oriented[0] == 2:
+		pattern = fmt.Sprintf("two opposite %v on top", upColor)
+	case len(oriented) == 2:
+		pattern = fmt.Sprintf("two adjacent %v on top", upColor)
+	case len(oriented) == 4:
+		pattern = fmt.Sprintf("all %v on top", upColor)
+	}
+
+	unsolvedCenters := 0
+
+	for _, c := range []struct {
+		center string
+		color  string
+	}{
+		{center: s.GetUpCenterColor(), color: upColor},
+		{center: s.GetFrontCenterColor(), color: drf[2]},
+		{center: s.GetRightCenterColor(), color: drf[1]},
+		{center: s.GetBackCenterColor(), color: dbr[1]},
+		{center: s.GetLeftCenterColor(), color: dfl[2]},
+	} {
+		if c.center != c.color {
+			unsolvedCenters++
+		}
+	}
+
+	if unsolvedCenters == 0 {
+		return pattern + ", centers solved", nil
+	}
+
+	return fmt.Sprintf("%v, %v centers unsolved", pattern, numberWords[unsolvedCenters]), nil
+}
@@ -0,0 +1,169 @@
+package skewb
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLayerByLayerLength(t *testing.T) {
+	for _, scramble := range []string{"", "R", "R U' B L'", "R L R' L' U B", "x U R B L U' R' B' L"} {
+		s := New("U", "F", "R", "B", "L", "D")
+		s.ApplyWCAMoves(scramble)
+		length, err := s.LayerByLayerLength()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		solution, err := s.SolveLayered()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, optimal := s.NearestSolved(); length != len(solution) || length < optimal {
+			t.Errorf("%q: got length %v for %v, the optimal solution has %v moves", scramble, length, solution, optimal)
+		}
+
+		if err := s.ApplyWCAMoves(joinMoves(solution)); err != nil {
+			t.Fatal(err)
+		}
+
+		if !s.IsSolved() {
+			t.Errorf("%q: %v does not solve the cube", scramble, solution)
+		}
+	}
+}
+
+// lastLayerStates builds every last layer state from the invariants of the skewb instead of the distance
+// table: with the down layer solved, the five other centers can only take an even permutation and the twists
+// of the two top corners of each tetrahedron, ufr with ubl and urb with ulf, have to add up to zero. That
+// gives 60 center arrangements times 9 twists.
+func lastLayerStates() []facelets {
+	states := []facelets{}
+	centers := []int{0, 1, 2, 3, 4}
+	var permute func(k int)
+
+	permute = func(k int) {
+		if k == len(centers) {
+			inversions := 0
+
+			for i := range centers {
+				for j := i + 1; j < len(centers); j++ {
+					if centers[i] > centers[j] {
+						inversions++
+					}
+				}
+			}
+
+			if inversions%2 != 0 {
+				return
+			}
+
+			for twists := range 9 {
+				state := solvedFacelets
+
+				for i, center := range centers {
+					state[24+i] = solvedFacelets[24+center]
+				}
+
+				for pair, corners := range [2][2]int{{ufrCorner, ublCorner}, {urbCorner, ulfCorner}} {
+					twist := []int{twists % 3, twists / 3}[pair]
+
+					for i := range 3 {
+						state[3*corners[0]+i] = solvedFacelets[3*corners[0]+(i+twist)%3]
+						state[3*corners[1]+i] = solvedFacelets[3*corners[1]+(i+3-twist)%3]
+					}
+				}
+
+				states = append(states, state)
+			}
+
+			return
+		}
+
+		for i := k; i < len(centers); i++ {
+			centers[k], centers[i] = centers[i], centers[k]
+			permute(k + 1)
+			centers[k], centers[i] = centers[i], centers[k]
+		}
+	}
+
+	permute(0)
+
+	return states
+}
+
+func TestAllLastLayerCases(t *testing.T) {
+	cases := AllLastLayerCases()
+	keys := map[string]bool{}
+
+	for _, state := range lastLayerStates() {
+		s := New("U", "F", "R", "B", "L", "D")
+		s.facelets = state
+
+		if _, err := s.Distance(); err != nil {
+			t.Fatalf("%v: a state built from the invariants is not solvable", state)
+		}
+
+		keys[s.CaseKey()] = true
+	}
+
+	if len(keys) != 137 || len(cases) != len(keys) {
+		t.Errorf("got %v last layer cases, the %v states built from the invariants give %v", len(cases), len(lastLayerStates()), len(keys))
+	}
+
+	for key := range keys {
+		if _, ok := cases[key]; !ok {
+			t.Errorf("%v: the case is missing", key)
+		}
+	}
+
+	swapped, twisted := New("U", "F", "R", "B", "L", "D"), New("U", "F", "R", "B", "L", "D")
+	swapped.facelets[24+frontCenter], swapped.facelets[24+rightCenter] = solvedFacelets[24+rightCenter], solvedFacelets[24+frontCenter]
+	twisted.facelets[3*ublCorner], twisted.facelets[3*ublCorner+1], twisted.facelets[3*ublCorner+2] = solvedFacelets[3*ublCorner+1], solvedFacelets[3*ublCorner+2], solvedFacelets[3*ublCorner]
+
+	for _, s := range []Skewb{swapped, twisted} {
+		if _, err := s.Distance(); err == nil {
+			t.Errorf("%v: a state breaking the invariants is solvable", s.facelets)
+		}
+	}
+
+	all := make([]Skewb, 0, len(cases))
+
+	for key, c := range cases {
+		if !c.IsLayerSolved("D") || !c.IsValid() || c.CaseKey() != key {
+			t.Errorf("%v: the case is not a valid last layer case with its key", key)
+		}
+
+		for _, other := range all {
+			if c.Equal(&other) {
+				t.Errorf("%v: two cases are Equal", key)
+			}
+		}
+
+		all = append(all, c)
+	}
+}
+
+func TestRecognitionHint(t *testing.T) {
+	for _, test := range []struct {
+		scramble string
+		hint     string
+		err      error
+	}{
+		{scramble: "", hint: "all White on top, centers solved"},
+		{scramble: "R' F R F'", hint: "no White on top, four centers unsolved"},
+		{scramble: "R' F R F' R' F R F'", hint: "no White on top, centers solved"},
+		{scramble: "R F' R' F", err: ErrFirstLayer},
+	} {
+		s := New("White", "Green", "Red", "Blue", "Orange", "Yellow")
+		s.ApplyRubiskewbMoves(test.scramble)
+
+		for range 2 {
+			if hint, err := s.RecognitionHint(); hint != test.hint || !errors.Is(err, test.err) {
+				t.Errorf("%q: got %q and %v, want %q and %v", test.scramble, hint, err, test.hint, test.err)
+			}
+		}
+	}
+}
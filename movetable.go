@@ -0,0 +1,127 @@
+package skewb
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+var ErrTableJSON = errors.New("move table json is invalid")
+
+// SolveMovesLine is one line of allSolveMoves.jsonl in algorithms/moves.zip, a Rubiskewb sequence and the
+// number of moves in it.
+type SolveMovesLine struct {
+	Depth int    `json:"depth"`
+	Moves string `json:"moves"`
+}
+
+// ValidateTableJSON checks allPreMoves.json, an array of premoves, or a table of solve moves written as one
+// object of arrays by depth, the format allSolveMoves.json had before allSolveMoves.jsonl replaced it.
+func ValidateTableJSON(data []byte) error {
+	var table any
+
+	if err := json.Unmarshal(data, &table); err != nil {
+		return fmt.Errorf("%w: %v", ErrTableJSON, err)
+	}
+
+	switch table := table.(type) {
+	case []any:
+		return validateMoveList(table, "premoves")
+	case map[string]any:
+		for depth, moves := range table {
+			if _, err := strconv.Atoi(depth); err != nil {
+				return fmt.Errorf("%w: depth %q is not a number", ErrTableJSON, depth)
+			}
+
+			list, ok := moves.([]any)
+
+			if !ok {
+				return fmt.Errorf("%w: depth %v is not an array", ErrTableJSON, depth)
+			}
+
+			if err := validateMoveList(list, fmt.Sprintf("depth %v", depth)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("%w: expected an array of premoves or an object of solve moves by depth", ErrTableJSON)
+	}
+}
+
+func validateMoveList(list []any, name string) error {
+	for i, moves := range list {
+		if _, ok := moves.(string); !ok {
+			return fmt.Errorf("%w: %v element %v is not a string", ErrTableJSON, name, i)
+		}
+	}
+
+	return nil
+}
+
+// ReadSolveMoves streams allSolveMoves.jsonl from r and calls fn with every line in order, so the table is
+// never held in memory whole. It stops at the first error fn returns, or with an error wrapping ErrTableJSON
+// at the first line that is not a valid SolveMovesLine: its depth has to be the number of its Rubiskewb
+// moves and must not be smaller than the depth of the line before.
+func ReadSolveMoves(r io.Reader, fn func(SolveMovesLine) error) error {
+	scanner := bufio.NewScanner(r)
+	depth := 0
+
+	for number := 1; scanner.Scan(); number++ {
+		line, err := parseSolveMovesLine(scanner.Bytes())
+
+		if err != nil {
+			return fmt.Errorf("%w: line %v: %v", ErrTableJSON, number, err)
+		}
+
+		if line.Depth < depth {
+			return fmt.Errorf("%w: line %v: depth %v follows depth %v", ErrTableJSON, number, line.Depth, depth)
+		}
+
+		depth = line.Depth
+
+		if err := fn(line); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// ValidateSolveMovesJSONL reads allSolveMoves.jsonl from r with ReadSolveMoves and returns its first error.
+func ValidateSolveMovesJSONL(r io.Reader) error {
+	return ReadSolveMoves(r, func(SolveMovesLine) error {
+		return nil
+	})
+}
+
+func parseSolveMovesLine(data []byte) (SolveMovesLine, error) {
+	var fields struct {
+		Depth *int    `json:"depth"`
+		Moves *string `json:"moves"`
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return SolveMovesLine{}, err
+	}
+
+	if fields.Depth == nil || fields.Moves == nil {
+		return SolveMovesLine{}, errors.New("depth and moves are required")
+	}
+
+	moves, err := parseNotationMoves(*fields.Moves, rubiskewbMovePermutations, ErrRubiskewbMove)
+
+	if err != nil {
+		return SolveMovesLine{}, err
+	}
+
+	if len(moves) != *fields.Depth {
+		return SolveMovesLine{}, fmt.Errorf("depth %v has %v moves", *fields.Depth, len(moves))
+	}
+
+	return SolveMovesLine{Depth: *fields.Depth, Moves: *fields.Moves}, nil
+}
@@ -0,0 +1,149 @@
+package skewb
+
+import (
+	"archive/zip"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestValidateTableJSON(t *testing.T) {
+	for _, test := range []struct {
+		data    string
+		message string
+	}{
+		{data: `["", "x", "x y"]`},
+		{data: `{"0": [""], "1": ["F", "R'"]}`},
+		{data: `["x", 1]`, message: "premoves element 1 is not a string"},
+		{data: `{"one": ["F"]}`, message: `depth "one" is not a number`},
+		{data: `{"1": "F"}`, message: "depth 1 is not an array"},
+		{data: `"F"`, message: "expected an array of premoves or an object of solve moves by depth"},
+		{data: `["F"`, message: "unexpected end of JSON input"},
+	} {
+		err := ValidateTableJSON([]byte(test.data))
+
+		if test.message == "" {
+			if err != nil {
+				t.Errorf("%v: got %v, want no error", test.data, err)
+			}
+
+			continue
+		}
+
+		if !errors.Is(err, ErrTableJSON) || !strings.Contains(err.Error(), test.message) {
+			t.Errorf("%v: got %v, want an ErrTableJSON saying %q", test.data, err, test.message)
+		}
+	}
+}
+
+func TestReadSolveMoves(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		data    string
+		lines   int
+		message string
+	}{
+		{name: "valid", data: "{\"depth\":0,\"moves\":\"\"}\n{\"depth\":1,\"moves\":\"F\"}\n{\"depth\":2,\"moves\":\"F r'\"}\n", lines: 3},
+		{name: "empty", data: "", lines: 0},
+		{name: "not json", data: "{\"depth\":0,\"moves\":\"\"}\n[\"F\"]\n", lines: 1, message: "line 2"},
+		{name: "missing field", data: "{\"depth\":1}\n", message: "depth and moves are required"},
+		{name: "wrong depth", data: "{\"depth\":2,\"moves\":\"F\"}\n", message: "depth 2 has 1 moves"},
+		{name: "unknown move", data: "{\"depth\":1,\"moves\":\"Q\"}\n", message: "Q"},
+		{name: "decreasing depth", data: "{\"depth\":1,\"moves\":\"F\"}\n{\"depth\":0,\"moves\":\"\"}\n", lines: 1, message: "depth 0 follows depth 1"},
+	} {
+		lines := 0
+		err := ReadSolveMoves(strings.NewReader(test.data), func(SolveMovesLine) error {
+			lines++
+
+			return nil
+		})
+
+		if lines != test.lines {
+			t.Errorf("%v: read %v lines, want %v", test.name, lines, test.lines)
+		}
+
+		if test.message == "" {
+			if err != nil {
+				t.Errorf("%v: got %v, want no error", test.name, err)
+			}
+
+			continue
+		}
+
+		if !errors.Is(err, ErrTableJSON) || !strings.Contains(err.Error(), test.message) {
+			t.Errorf("%v: got %v, want an ErrTableJSON saying %q", test.name, err, test.message)
+		}
+	}
+
+	stop := errors.New("stop")
+
+	if err := ReadSolveMoves(strings.NewReader("{\"depth\":0,\"moves\":\"\"}\n"), func(SolveMovesLine) error {
+		return stop
+	}); err != stop {
+		t.Errorf("got %v, want the error of the callback", err)
+	}
+}
+
+// TestMovesArchive checks that algorithms/moves.zip holds the premoves and the solve moves in the formats the
+// readers expect. Only the solve moves up to depth 5 are read, the whole table takes most of a minute.
+func TestMovesArchive(t *testing.T) {
+	archive, err := zip.OpenReader("algorithms/moves.zip")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer archive.Close()
+
+	preMoves, err := archive.Open("allPreMoves.json")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer preMoves.Close()
+
+	data, err := io.ReadAll(preMoves)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ValidateTableJSON(data); err != nil {
+		t.Error(err)
+	}
+
+	solveMoves, err := archive.Open("allSolveMoves.jsonl")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer solveMoves.Close()
+
+	done := errors.New("depth 6 reached")
+	counts := []int{}
+	err = ReadSolveMoves(solveMoves, func(line SolveMovesLine) error {
+		if line.Depth > 5 {
+			return done
+		}
+
+		if line.Depth == len(counts) {
+			counts = append(counts, 0)
+		}
+
+		counts[line.Depth]++
+
+		return nil
+	})
+
+	if err != done {
+		t.Fatal(err)
+	}
+
+	if want := []int{1, 10, 76, 604, 4804, 38404}; !slices.Equal(counts, want) {
+		t.Errorf("got %v sequences by depth, want %v", counts, want)
+	}
+}
@@ -0,0 +1,340 @@
+package skewb
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrMove     = errors.New("move is not supported")
+	ErrNotation = errors.New("notations do not agree")
+	ErrIdentity = errors.New("sequence does not return a solved cube to solved")
+
+	twizzleURL = "https://alpha.twizzle.net/edit/"
+
+	// A half rotation is its own inverse, so some scramble sources write x2 as x2'.
+	moveAliases = map[Move]Move{"x2'": X2, "y2'": Y2, "z2'": Z2}
+)
+
+func ParseMoves(moves string) ([]Move, error) {
+	tokens := splitMoves(moves)
+	result := make([]Move, 0, len(tokens))
+
+	for _, token := range tokens {
+		move := normalizeMove(Move(token))
+
+		if !isKnownMove(move) {
+			return nil, fmt.Errorf("%v %w", move, ErrMove)
+		}
+
+		result = append(result, move)
+	}
+
+	return result, nil
+}
+
+func normalizeMove(move Move) Move {
+	if alias, ok := moveAliases[move]; ok {
+		return alias
+	}
+
+	return move
+}
+
+// splitMoves separates moves on any run of whitespace or commas, so pasted sequences like "R,  U\n x2"
+// give no empty tokens.
+func splitMoves(moves string) []string {
+	return strings.FieldsFunc(moves, func(r rune) bool {
+		return unicode.IsSpace(r) || r == ','
+	})
+}
+
+func CountMoves(moves string) (etm int, stm int, err error) {
+	parsed, err := ParseMoves(moves)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, move := range parsed {
+		etm++
+
+		if move.IsFaceTurn() {
+			stm++
+		}
+	}
+
+	return etm, stm, nil
+}
+
+// IsRotation reports whether the move turns the whole cube: x, y, z and their prime and double variants.
+func (m Move) IsRotation() bool {
+	return isRotation(m)
+}
+
+// IsFaceTurn reports whether the move turns one corner of the cube in WCA or Rubiskewb notation.
+func (m Move) IsFaceTurn() bool {
+	return isKnownMove(m) && !isRotation(m)
+}
+
+// Inverse returns the move undoing m: R for R', R' for R and for R2, and x2 for x2.
+func (m Move) Inverse() Move {
+	return inverseMove(m)
+}
+
+func isKnownMove(move Move) bool {
+	switch move {
+	case U, UPrime, R, RPrime, LittleR, LittleRPrime, B, BPrime, LittleB, LittleBPrime, L, LPrime, LittleL, LittleLPrime, F, FPrime, LittleF, LittleFPrime:
+		return true
+	case U2, R2, LittleR2, B2, LittleB2, L2, LittleL2, F2, LittleF2:
+		return true
+	case X, XPrime, X2, Y, YPrime, Y2, Z, ZPrime, Z2:
+		return true
+	default:
+		return false
+	}
+}
+
+func isRotation(move Move) bool {
+	switch move {
+	case X, XPrime, X2, Y, YPrime, Y2, Z, ZPrime, Z2:
+		return true
+	default:
+		return false
+	}
+}
+
+var (
+	wcaToRubiskewb = map[Move]string{
+		U:      "B",
+		UPrime: "B'",
+		R:      "r",
+		RPrime: "r'",
+		B:      "b",
+		BPrime: "b'",
+		L:      "l",
+		LPrime: "l'",
+	}
+
+	// Rubiskewb r, l and b are WCA R, L and B and Rubiskewb B is WCA U. Rubiskewb R, L, F and f turn corners
+	// that WCA notation keeps fixed, so they are written as a turn of the opposite corner followed by the
+	// whole cube rotation that puts the pieces back in place.
+	rubiskewbToWCA = map[Move]string{
+		R:            "L z' y",
+		RPrime:       "L' x' y'",
+		LittleR:      "R",
+		LittleRPrime: "R'",
+		B:            "U",
+		BPrime:       "U'",
+		LittleB:      "B",
+		LittleBPrime: "B'",
+		L:            "R z y",
+		LPrime:       "R' x y'",
+		LittleL:      "L",
+		LittleLPrime: "L'",
+		F:            "B x y",
+		FPrime:       "B' z' y'",
+		LittleF:      "U z y'",
+		LittleFPrime: "U' x' y",
+	}
+)
+
+func WCAToRubiskewb(moves string) (string, error) {
+	return translateMoves(moves, wcaToRubiskewb, ErrWCAMove)
+}
+
+func RubiskewbToWCA(moves string) (string, error) {
+	return translateMoves(moves, rubiskewbToWCA, ErrRubiskewbMove)
+}
+
+// VerifyNotationConsistency checks that every move of each notation, applied to a solved cube, gives the same
+// state as its translation into the other notation.
+func VerifyNotationConsistency() error {
+	for _, check := range []struct {
+		translation    map[Move]string
+		from, to       string
+		apply, applyTo func(*Skewb, string) error
+	}{
+		{translation: wcaToRubiskewb, from: "wca", to: "rubiskewb", apply: (*Skewb).ApplyWCAMoves, applyTo: (*Skewb).ApplyRubiskewbMoves},
+		{translation: rubiskewbToWCA, from: "rubiskewb", to: "wca", apply: (*Skewb).ApplyRubiskewbMoves, applyTo: (*Skewb).ApplyWCAMoves},
+	} {
+		for move, translated := range check.translation {
+			original := New("U", "F", "R", "B", "L", "D")
+			expected := New("U", "F", "R", "B", "L", "D")
+
+			if err := check.apply(&original, string(move)); err != nil {
+				return err
+			}
+
+			if err := check.applyTo(&expected, translated); err != nil {
+				return err
+			}
+
+			if !original.ExactEqual(&expected) {
+				return fmt.Errorf("%v %v %v %v %w", check.from, move, check.to, translated, ErrNotation)
+			}
+		}
+	}
+
+	return nil
+}
+
+// CheckRoundTrip applies the WCA moves and then their reverse to a solved cube and returns an error wrapping
+// ErrIdentity unless it is solved again, rotations included.
+func CheckRoundTrip(moves string) error {
+	return checkIdentity(moves + " " + createReverse(moves))
+}
+
+func checkIdentity(identity string) error {
+	solved := New("U", "F", "R", "B", "L", "D")
+	s := New("U", "F", "R", "B", "L", "D")
+
+	if err := s.ApplyWCAMoves(identity); err != nil {
+		return err
+	}
+
+	if !s.ExactEqual(&solved) {
+		return fmt.Errorf("%v %w", strings.TrimSpace(identity), ErrIdentity)
+	}
+
+	return nil
+}
+
+// translateMoves looks up the face turns without their 2 suffix. A turn that translates to a single move gets
+// the suffix back, so R2 becomes r2, and a longer translation is written twice.
+func translateMoves(moves string, translation map[Move]string, errMove error) (string, error) {
+	result := []string{}
+
+	for _, m := range splitMoves(moves) {
+		move := normalizeMove(Move(m))
+		base, double := strings.CutSuffix(string(move), "2")
+		translated := translation[Move(base)]
+
+		switch {
+		case isRotation(move):
+			result = append(result, string(move))
+		case translated != "" && double && !strings.Contains(translated, " "):
+			result = append(result, translated+"2")
+		case translated != "" && double:
+			result = append(result, translated, translated)
+		case translated != "":
+			result = append(result, translated)
+		default:
+			return "", fmt.Errorf("%v %w", move, errMove)
+		}
+	}
+
+	return strings.Join(result, " "), nil
+}
+
+// MovesEquivalent applies the WCA sequences a and b to solved cubes and reports whether they end in the
+// same state, whole cube rotations included.
+func MovesEquivalent(a, b string) (bool, error) {
+	first, second, err := applyToSolved(a, b)
+
+	if err != nil {
+		return false, err
+	}
+
+	return first.ExactEqual(&second), nil
+}
+
+// MovesEquivalentUpToOrientation is MovesEquivalent comparing with Equal, so sequences that only differ
+// by a whole cube rotation are equivalent.
+func MovesEquivalentUpToOrientation(a, b string) (bool, error) {
+	first, second, err := applyToSolved(a, b)
+
+	if err != nil {
+		return false, err
+	}
+
+	return first.Equal(&second), nil
+}
+
+func applyToSolved(a, b string) (Skewb, Skewb, error) {
+	first := New("U", "F", "R", "B", "L", "D")
+	second := New("U", "F", "R", "B", "L", "D")
+
+	if err := first.ApplyWCAMoves(a); err != nil {
+		return Skewb{}, Skewb{}, err
+	}
+
+	if err := second.ApplyWCAMoves(b); err != nil {
+		return Skewb{}, Skewb{}, err
+	}
+
+	return first, second, nil
+}
+
+// TwizzleURL links the WCA sequence to the Twizzle editor of cubing.js with the skewb puzzle selected.
+func TwizzleURL(moves string) string {
+	query := url.Values{
+		"puzzle": {"skewb"},
+		"alg":    {strings.Join(splitMoves(moves), " ")},
+	}
+
+	return twizzleURL + "?" + query.Encode()
+}
+
+// simplifyMoves merges adjacent turns of the same face or around the same axis, so "R R" becomes "R'" and
+// "x x'" disappears. Face turns are counted modulo 3 and rotations modulo 4; merging repeats while a
+// cancellation brings two turns of the same face next to each other.
+func simplifyMoves(moves []Move) []Move {
+	type turn struct {
+		base   string
+		amount int
+	}
+
+	stack := []turn{}
+
+	for _, move := range moves {
+		base := strings.TrimRight(string(move), "'2")
+		amount := 1
+
+		switch {
+		case strings.HasSuffix(string(move), "2"):
+			amount = 2
+		case strings.HasSuffix(string(move), "'"):
+			amount = -1
+		}
+
+		order := 3
+
+		if move.IsRotation() {
+			order = 4
+		}
+
+		if len(stack) > 0 && stack[len(stack)-1].base == base {
+			amount += stack[len(stack)-1].amount
+			stack = stack[:len(stack)-1]
+		}
+
+		if amount = (amount%order + order) % order; amount != 0 {
+			stack = append(stack, turn{base: base, amount: amount})
+		}
+	}
+
+	result := make([]Move, len(stack))
+
+	for i, t := range stack {
+		order := 3
+
+		if Move(t.base).IsRotation() {
+			order = 4
+		}
+
+		switch {
+		case t.amount == 1:
+			result[i] = Move(t.base)
+		case t.amount == order-1:
+			result[i] = Move(t.base + "'")
+		default:
+			result[i] = Move(t.base + "2")
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,195 @@
+package skewb
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestTranslateMoves(t *testing.T) {
+	for _, test := range []struct {
+		translate   func(string) (string, error)
+		moves, want string
+	}{
+		{translate: WCAToRubiskewb, moves: "R2", want: "r2"},
+		{translate: WCAToRubiskewb, moves: "U2 x2 L'", want: "B2 x2 l'"},
+		{translate: RubiskewbToWCA, moves: "r2 B2", want: "R2 U2"},
+		{translate: RubiskewbToWCA, moves: "R2", want: "L z' y L z' y"},
+	} {
+		got, err := test.translate(test.moves)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != test.want {
+			t.Errorf("%q: got %q, want %q", test.moves, got, test.want)
+		}
+	}
+
+	if _, err := WCAToRubiskewb("F2"); !errors.Is(err, ErrWCAMove) {
+		t.Errorf("got %v, want ErrWCAMove", err)
+	}
+
+	if _, err := RubiskewbToWCA("U"); !errors.Is(err, ErrRubiskewbMove) || !errors.Is(err, ErrWCAMove) {
+		t.Errorf("got %v, want ErrRubiskewbMove matching ErrWCAMove", err)
+	}
+}
+
+func TestDoubleTurns(t *testing.T) {
+	for _, test := range []struct {
+		apply          func(*Skewb, string) error
+		double, single string
+	}{
+		{apply: (*Skewb).ApplyWCAMoves, double: "R2", single: "R'"},
+		{apply: (*Skewb).ApplyWCAMoves, double: "U2 B2", single: "U' B'"},
+		{apply: (*Skewb).ApplyRubiskewbMoves, double: "r2 F2", single: "r' F'"},
+	} {
+		s := New("U", "F", "R", "B", "L", "D")
+		other := New("U", "F", "R", "B", "L", "D")
+
+		if err := test.apply(&s, test.double); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := test.apply(&other, test.single); err != nil {
+			t.Fatal(err)
+		}
+
+		if !s.ExactEqual(&other) {
+			t.Errorf("%q is not %q", test.double, test.single)
+		}
+	}
+
+	for _, moves := range []string{"R2 L2 x", "r2 B2 F2 y'"} {
+		wca, err := RubiskewbToWCA(moves)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		s := New("U", "F", "R", "B", "L", "D")
+		other := New("U", "F", "R", "B", "L", "D")
+		s.ApplyRubiskewbMoves(moves)
+		other.ApplyWCAMoves(wca)
+
+		if !s.ExactEqual(&other) {
+			t.Errorf("%q: the translation %q gives another state", moves, wca)
+		}
+	}
+}
+
+func TestParseMoves(t *testing.T) {
+	for _, test := range []struct {
+		moves string
+		want  []Move
+	}{
+		{moves: "", want: []Move{}},
+		{moves: "R U' x2", want: []Move{R, UPrime, X2}},
+		{moves: "R,  U\n x2", want: []Move{R, U, X2}},
+		{moves: " ,R,,\tB' ,", want: []Move{R, BPrime}},
+		{moves: "y2' r2", want: []Move{Y2, LittleR2}},
+	} {
+		got, err := ParseMoves(test.moves)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !slices.Equal(got, test.want) {
+			t.Errorf("%q: got %q, want %q", test.moves, got, test.want)
+		}
+	}
+
+	if _, err := ParseMoves("R Q"); !errors.Is(err, ErrMove) {
+		t.Errorf("got %v, want ErrMove", err)
+	}
+}
+
+func TestVerifyNotationConsistency(t *testing.T) {
+	if err := VerifyNotationConsistency(); err != nil {
+		t.Fatal(err)
+	}
+
+	saved := wcaToRubiskewb[R]
+	wcaToRubiskewb[R] = "r'"
+	err := VerifyNotationConsistency()
+	wcaToRubiskewb[R] = saved
+
+	if !errors.Is(err, ErrNotation) {
+		t.Errorf("got %v for a wrong translation, want ErrNotation", err)
+	}
+}
+
+func TestHalfRotationAliases(t *testing.T) {
+	for _, test := range []struct {
+		apply func(*Skewb, string) error
+		alias Move
+		move  Move
+	}{
+		{apply: (*Skewb).ApplyWCAMoves, alias: "x2'", move: X2},
+		{apply: (*Skewb).ApplyWCAMoves, alias: "y2'", move: Y2},
+		{apply: (*Skewb).ApplyRubiskewbMoves, alias: "z2'", move: Z2},
+	} {
+		s := New("U", "F", "R", "B", "L", "D")
+		other := New("U", "F", "R", "B", "L", "D")
+
+		if err := test.apply(&s, "R "+string(test.alias)); err != nil {
+			t.Fatal(err)
+		}
+
+		test.apply(&other, "R "+string(test.move))
+
+		if !s.ExactEqual(&other) {
+			t.Errorf("%v is not %v", test.alias, test.move)
+		}
+
+		if history := s.History(); !slices.Equal(history, []Move{R, test.move}) {
+			t.Errorf("%v: got history %q, want the normalized move", test.alias, history)
+		}
+	}
+
+	if translated, err := WCAToRubiskewb("x2' R"); err != nil || translated != "x2 r" {
+		t.Errorf("got %q and %v, want \"x2 r\"", translated, err)
+	}
+}
+
+func TestMoveMethods(t *testing.T) {
+	for _, move := range AllMoves {
+		if move.IsRotation() == move.IsFaceTurn() {
+			t.Errorf("%v: IsRotation and IsFaceTurn are both %v", move, move.IsRotation())
+		}
+
+		if move.IsRotation() != slices.Contains(WCARotations, move) {
+			t.Errorf("%v: IsRotation is %v", move, move.IsRotation())
+		}
+
+		inverse := move.Inverse()
+
+		if !slices.Contains(AllMoves, inverse) {
+			t.Errorf("%v: the inverse %v is not a move", move, inverse)
+		}
+
+		applied := false
+
+		for _, permutations := range []map[Move]facelets{wcaMovePermutations, rubiskewbMovePermutations} {
+			if _, ok := permutations[move]; !ok {
+				continue
+			}
+
+			applied = true
+
+			if state := solvedFacelets.permute(permutations[move]).permute(permutations[inverse]); state != solvedFacelets {
+				t.Errorf("%v: %v does not undo it", move, inverse)
+			}
+		}
+
+		if !applied {
+			t.Errorf("%v: no notation applies it", move)
+		}
+	}
+
+	if unknown := Move("Q"); unknown.IsRotation() || unknown.IsFaceTurn() {
+		t.Error("an unknown move is a rotation or a face turn")
+	}
+}
@@ -0,0 +1,168 @@
+package skewb
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type SymmetryGrouper interface {
+	SymmetryGroup() []string
+}
+
+type OrientationsGetter interface {
+	Orientations() []Skewb
+}
+
+type Canonicalizer interface {
+	Canonicalize()
+}
+
+type Aligner interface {
+	AlignTo(other Skewber) (string, bool)
+}
+
+type Orienter interface {
+	Orient(upColor, frontColor string) error
+}
+
+var (
+	ErrOrientation = errors.New("colors can not be on the up and front centers at the same time")
+
+	upRotations    = []string{"", "x", "x'", "x2", "z", "z'"}
+	frontRotations = []string{"", "y", "y'", "y2"}
+)
+
+func orientationRotations() []string {
+	rotations := make([]string, 0, len(upRotations)*len(frontRotations))
+
+	for _, upRotation := range upRotations {
+		for _, frontRotation := range frontRotations {
+			rotations = append(rotations, strings.TrimSpace(upRotation+" "+frontRotation))
+		}
+	}
+
+	return rotations
+}
+
+// Orientations returns the cube held in each of the 24 orientations, in the order of
+// orientationRotations, the first one being the cube as it is. Each copy has the rotation that produced it
+// as its history, so Undo turns it back into the receiver.
+func (s *Skewb) Orientations() []Skewb {
+	orientations := make([]Skewb, 0, len(upRotations)*len(frontRotations))
+
+	for _, rotation := range orientationRotations() {
+		rotated := s.clone()
+
+		if rotation != "" {
+			rotated.ApplyWCAMoves(rotation)
+		}
+
+		orientations = append(orientations, rotated)
+	}
+
+	return orientations
+}
+
+// SymmetryGroup returns the rotations the state is invariant under. It compares the colors relative to the
+// centers, so a solved cube is invariant under all 24 rotations while most scrambles only are under the
+// identity, which is returned as an empty string.
+func (s *Skewb) SymmetryGroup() []string {
+	symmetries := []string{}
+
+	for _, rotation := range orientationRotations() {
+		rotated := s.clone()
+
+		if rotation != "" {
+			rotated.ApplyWCAMoves(rotation)
+		}
+
+		if getRelativeColors(s) == getRelativeColors(&rotated) {
+			symmetries = append(symmetries, rotation)
+		}
+	}
+
+	return symmetries
+}
+
+// Orient rotates the whole cube until upColor is on the up center and frontColor on the front center. Like
+// CenterDown, the rotation goes through ApplyWCAMoves and is recorded in the history.
+func (s *Skewb) Orient(upColor, frontColor string) error {
+	colors := s.faceletColors()
+
+	for _, color := range []string{upColor, frontColor} {
+		if !slices.Contains(colors[24:], color) {
+			return fmt.Errorf("%v %w", color, ErrColor)
+		}
+	}
+
+	for _, rotation := range orientationRotations() {
+		rotated := s.clone()
+
+		if rotation != "" {
+			rotated.ApplyWCAMoves(rotation)
+		}
+
+		if rotated.GetUpCenterColor() == upColor && rotated.GetFrontCenterColor() == frontColor {
+			if rotation == "" {
+				return nil
+			}
+
+			return s.ApplyWCAMoves(rotation)
+		}
+	}
+
+	return fmt.Errorf("%v %v %w", upColor, frontColor, ErrOrientation)
+}
+
+// Canonicalize rotates the cube into the orientation with the smallest facelet string, the one CanonicalHash
+// hashes. Cubes with the same colors are Equal exactly when they are ExactEqual after both are
+// canonicalized; Equal itself still searches the orientations with the same down center.
+func (s *Skewb) Canonicalize() {
+	orientations := s.Orientations()
+	best := 0
+
+	for i, orientation := range orientations {
+		if orientation.faceletString() < orientations[best].faceletString() {
+			best = i
+		}
+	}
+
+	if rotation := orientationRotations()[best]; rotation != "" {
+		s.ApplyWCAMoves(rotation)
+	}
+}
+
+// AlignTo returns the whole cube rotation, one of the 24 orientations, that turns other into exactly the
+// receiver, and false when no orientation does, so other is not Equal to it. Like FullMirror it tries the
+// rotations on a copy of a *Skewb and undoes each one again on any other Skewber.
+func (s *Skewb) AlignTo(other Skewber) (string, bool) {
+	other = reorientable(other)
+
+	for _, rotation := range orientationRotations() {
+		if rotation == "" {
+			if s.equal(other) {
+				return "", true
+			}
+
+			continue
+		}
+
+		if err := other.ApplyWCAMoves(rotation); err != nil {
+			return "", false
+		}
+
+		aligned := s.equal(other)
+
+		if err := other.ApplyWCAMoves(createReverse(rotation)); err != nil {
+			return "", false
+		}
+
+		if aligned {
+			return rotation, true
+		}
+	}
+
+	return "", false
+}
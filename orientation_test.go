@@ -0,0 +1,28 @@
+package skewb
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSymmetryGroup(t *testing.T) {
+	for _, test := range []struct {
+		scramble string
+		group    []string
+	}{
+		{scramble: "", group: orientationRotations()},
+		{scramble: "x y'", group: orientationRotations()},
+		{scramble: "R U' B L' R", group: []string{""}},
+	} {
+		s := New("U", "F", "R", "B", "L", "D")
+		s.ApplyWCAMoves(test.scramble)
+
+		if group := s.SymmetryGroup(); !slices.Equal(group, test.group) {
+			t.Errorf("%q: got %q, want %q", test.scramble, group, test.group)
+		}
+	}
+
+	if length := len(orientationRotations()); length != 24 {
+		t.Errorf("got %v orientations, want 24", length)
+	}
+}
@@ -0,0 +1,79 @@
+package skewb
+
+import (
+	"maps"
+	"strings"
+)
+
+// Each permutation lists, for every facelet in ufr, urb, ulf, ubl, drf, dbr, dfl, dlb, up, front, right,
+// back, left, down order, the facelet its color comes from after the move.
+var (
+	rotationPermutations = map[Move]facelets{
+		X:      {14, 12, 13, 1, 2, 0, 19, 20, 18, 8, 6, 7, 16, 17, 15, 5, 3, 4, 23, 21, 22, 10, 11, 9, 25, 29, 26, 24, 28, 27},
+		XPrime: {5, 3, 4, 16, 17, 15, 10, 11, 9, 23, 21, 22, 1, 2, 0, 14, 12, 13, 8, 6, 7, 19, 20, 18, 27, 24, 26, 29, 28, 25},
+		X2:     {15, 16, 17, 12, 13, 14, 21, 22, 23, 18, 19, 20, 3, 4, 5, 0, 1, 2, 9, 10, 11, 6, 7, 8, 29, 27, 26, 25, 28, 24},
+		Y:      {3, 4, 5, 9, 10, 11, 0, 1, 2, 6, 7, 8, 15, 16, 17, 21, 22, 23, 12, 13, 14, 18, 19, 20, 24, 26, 27, 28, 25, 29},
+		YPrime: {6, 7, 8, 0, 1, 2, 9, 10, 11, 3, 4, 5, 18, 19, 20, 12, 13, 14, 21, 22, 23, 15, 16, 17, 24, 28, 25, 26, 27, 29},
+		Y2:     {9, 10, 11, 6, 7, 8, 3, 4, 5, 0, 1, 2, 21, 22, 23, 18, 19, 20, 15, 16, 17, 12, 13, 14, 24, 27, 28, 25, 26, 29},
+		Z:      {7, 8, 6, 11, 9, 10, 20, 18, 19, 22, 23, 21, 2, 0, 1, 4, 5, 3, 13, 14, 12, 17, 15, 16, 28, 25, 24, 27, 29, 26},
+		ZPrime: {13, 14, 12, 17, 15, 16, 2, 0, 1, 4, 5, 3, 20, 18, 19, 22, 23, 21, 7, 8, 6, 11, 9, 10, 26, 25, 29, 27, 24, 28},
+		Z2:     {18, 19, 20, 21, 22, 23, 12, 13, 14, 15, 16, 17, 6, 7, 8, 9, 10, 11, 0, 1, 2, 3, 4, 5, 29, 25, 28, 27, 26, 24},
+	}
+
+	wcaPermutations = map[Move]facelets{
+		U:      {0, 1, 2, 23, 21, 22, 5, 3, 4, 10, 11, 9, 12, 13, 14, 15, 16, 17, 18, 19, 20, 8, 6, 7, 27, 25, 26, 28, 24, 29},
+		UPrime: {0, 1, 2, 7, 8, 6, 22, 23, 21, 11, 9, 10, 12, 13, 14, 15, 16, 17, 18, 19, 20, 4, 5, 3, 28, 25, 26, 24, 27, 29},
+		R:      {0, 1, 2, 14, 12, 13, 6, 7, 8, 9, 10, 11, 23, 21, 22, 16, 17, 15, 18, 19, 20, 5, 3, 4, 24, 25, 29, 26, 28, 27},
+		RPrime: {0, 1, 2, 22, 23, 21, 6, 7, 8, 9, 10, 11, 4, 5, 3, 17, 15, 16, 18, 19, 20, 13, 14, 12, 24, 25, 27, 29, 28, 26},
+		B:      {0, 1, 2, 3, 4, 5, 6, 7, 8, 17, 15, 16, 12, 13, 14, 20, 18, 19, 11, 9, 10, 22, 23, 21, 24, 25, 26, 29, 27, 28},
+		BPrime: {0, 1, 2, 3, 4, 5, 6, 7, 8, 19, 20, 18, 12, 13, 14, 10, 11, 9, 16, 17, 15, 23, 21, 22, 24, 25, 26, 28, 29, 27},
+		L:      {0, 1, 2, 3, 4, 5, 23, 21, 22, 9, 10, 11, 8, 6, 7, 15, 16, 17, 19, 20, 18, 14, 12, 13, 24, 28, 26, 27, 29, 25},
+		LPrime: {0, 1, 2, 3, 4, 5, 13, 14, 12, 9, 10, 11, 22, 23, 21, 15, 16, 17, 20, 18, 19, 7, 8, 6, 24, 29, 26, 27, 25, 28},
+	}
+
+	rubiskewbPermutations = map[Move]facelets{
+		R:            {17, 15, 16, 4, 5, 3, 6, 7, 8, 2, 0, 1, 12, 13, 14, 11, 9, 10, 18, 19, 20, 21, 22, 23, 26, 25, 27, 24, 28, 29},
+		RPrime:       {10, 11, 9, 5, 3, 4, 6, 7, 8, 16, 17, 15, 12, 13, 14, 1, 2, 0, 18, 19, 20, 21, 22, 23, 27, 25, 24, 26, 28, 29},
+		LittleR:      {0, 1, 2, 14, 12, 13, 6, 7, 8, 9, 10, 11, 23, 21, 22, 16, 17, 15, 18, 19, 20, 5, 3, 4, 24, 25, 29, 26, 28, 27},
+		LittleRPrime: {0, 1, 2, 22, 23, 21, 6, 7, 8, 9, 10, 11, 4, 5, 3, 17, 15, 16, 18, 19, 20, 13, 14, 12, 24, 25, 27, 29, 28, 26},
+		B:            {0, 1, 2, 23, 21, 22, 5, 3, 4, 10, 11, 9, 12, 13, 14, 15, 16, 17, 18, 19, 20, 8, 6, 7, 27, 25, 26, 28, 24, 29},
+		BPrime:       {0, 1, 2, 7, 8, 6, 22, 23, 21, 11, 9, 10, 12, 13, 14, 15, 16, 17, 18, 19, 20, 4, 5, 3, 28, 25, 26, 24, 27, 29},
+		LittleB:      {0, 1, 2, 3, 4, 5, 6, 7, 8, 17, 15, 16, 12, 13, 14, 20, 18, 19, 11, 9, 10, 22, 23, 21, 24, 25, 26, 29, 27, 28},
+		LittleBPrime: {0, 1, 2, 3, 4, 5, 6, 7, 8, 19, 20, 18, 12, 13, 14, 10, 11, 9, 16, 17, 15, 23, 21, 22, 24, 25, 26, 28, 29, 27},
+		L:            {11, 9, 10, 3, 4, 5, 7, 8, 6, 20, 18, 19, 12, 13, 14, 15, 16, 17, 2, 0, 1, 21, 22, 23, 28, 24, 26, 27, 25, 29},
+		LPrime:       {19, 20, 18, 3, 4, 5, 8, 6, 7, 1, 2, 0, 12, 13, 14, 15, 16, 17, 10, 11, 9, 21, 22, 23, 25, 28, 26, 27, 24, 29},
+		LittleL:      {0, 1, 2, 3, 4, 5, 23, 21, 22, 9, 10, 11, 8, 6, 7, 15, 16, 17, 19, 20, 18, 14, 12, 13, 24, 28, 26, 27, 29, 25},
+		LittleLPrime: {0, 1, 2, 3, 4, 5, 13, 14, 12, 9, 10, 11, 22, 23, 21, 15, 16, 17, 20, 18, 19, 7, 8, 6, 24, 29, 26, 27, 25, 28},
+		F:            {1, 2, 0, 8, 6, 7, 14, 12, 13, 9, 10, 11, 5, 3, 4, 15, 16, 17, 18, 19, 20, 21, 22, 23, 25, 26, 24, 27, 28, 29},
+		FPrime:       {2, 0, 1, 13, 14, 12, 4, 5, 3, 9, 10, 11, 7, 8, 6, 15, 16, 17, 18, 19, 20, 21, 22, 23, 26, 24, 25, 27, 28, 29},
+		LittleF:      {20, 18, 19, 3, 4, 5, 6, 7, 8, 9, 10, 11, 13, 14, 12, 2, 0, 1, 17, 15, 16, 21, 22, 23, 24, 29, 25, 27, 28, 26},
+		LittleFPrime: {16, 17, 15, 3, 4, 5, 6, 7, 8, 9, 10, 11, 14, 12, 13, 19, 20, 18, 1, 2, 0, 21, 22, 23, 24, 26, 29, 27, 28, 25},
+	}
+
+	// mirrorPermutations reflect the cube across the plane between the L and R, the F and B or the U and D
+	// faces. A corner goes to its mirror position with its two stickers off the plane's normal exchanged, so
+	// it takes the opposite handedness.
+	mirrorPermutations = map[string]facelets{
+		"LR": {6, 8, 7, 9, 11, 10, 0, 2, 1, 3, 5, 4, 18, 20, 19, 21, 23, 22, 12, 14, 13, 15, 17, 16, 24, 25, 28, 27, 26, 29},
+		"FB": {3, 5, 4, 0, 2, 1, 9, 11, 10, 6, 8, 7, 15, 17, 16, 12, 14, 13, 21, 23, 22, 18, 20, 19, 24, 27, 26, 25, 28, 29},
+		"UD": {12, 14, 13, 15, 17, 16, 18, 20, 19, 21, 23, 22, 0, 2, 1, 3, 5, 4, 6, 8, 7, 9, 11, 10, 29, 25, 26, 27, 28, 24},
+	}
+
+	wcaMovePermutations       = mergePermutations(wcaPermutations, rotationPermutations)
+	rubiskewbMovePermutations = mergePermutations(rubiskewbPermutations, rotationPermutations)
+)
+
+// mergePermutations also adds every face turn followed by "2", the turn applied twice, which for a 120
+// degree corner turn is the same as its inverse.
+func mergePermutations(faceMoves, rotations map[Move]facelets) map[Move]facelets {
+	merged := maps.Clone(faceMoves)
+	maps.Copy(merged, rotations)
+
+	for move, permutation := range faceMoves {
+		if !strings.HasSuffix(string(move), "'") {
+			merged[move+"2"] = permutation.permute(permutation)
+		}
+	}
+
+	return merged
+}
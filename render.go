@@ -0,0 +1,330 @@
+package skewb
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"strings"
+)
+
+type FramesRenderer interface {
+	RenderFrames(moves string) ([]image.Image, error)
+}
+
+type FaceDrawer interface {
+	DrawFace(w io.Writer, face string) error
+}
+
+type FullDrawer interface {
+	DrawFull(fileName string) error
+}
+
+type StickerLocator interface {
+	StickerAt(x, y float64) (piece string, layer int, ok bool)
+}
+
+type GeometryGetter interface {
+	Geometry() []Sticker
+}
+
+// Sticker is one polygon of the net drawn by Draw, its vertices in pixels of that image.
+type Sticker struct {
+	Color    string
+	Vertices [][2]float64
+}
+
+// Renderer fills the stickers, in order and outlined as set by style, on a transparent width by height
+// image.
+type Renderer func(stickers []Sticker, width, height int, style DrawStyle) *image.RGBA
+
+type SequenceGIFDrawer interface {
+	DrawSequenceGIF(w io.Writer, moves string, delayMs int) error
+}
+
+var (
+	ErrImageSize = errors.New("images have different sizes")
+	ErrFace      = errors.New("face is not supported; valid faces are: \"up\", \"front\", \"right\", \"back\", \"left\", \"down\"")
+	// netFaceOrigins places the faces of DrawFull in a cross, in faceletFaces order, in units of one face.
+	netFaceOrigins = [6][2]float64{{1, 0}, {2, 1}, {1, 1}, {1, 2}, {0, 1}, {3, 1}}
+	netFaceSize    = 120.0
+
+	ErrGrid       = errors.New("grid needs at least one state and one column")
+	ErrNoRenderer = errors.New("no renderer is registered; import github.com/Rasek91/skewb/draw")
+
+	renderer Renderer
+)
+
+// RegisterRenderer sets the renderer used by Draw and the other drawing methods. The draw subpackage
+// registers itself when imported, so the core package does not depend on a graphics library.
+func RegisterRenderer(r Renderer) {
+	renderer = r
+}
+
+func (s *Skewb) RenderFrames(moves string) ([]image.Image, error) {
+	states, err := s.Trace(moves)
+
+	if err != nil {
+		return nil, err
+	}
+
+	frames := make([]image.Image, len(states))
+
+	for i, state := range states {
+		if frames[i], err = state.render(DefaultDrawStyle); err != nil {
+			return nil, err
+		}
+	}
+
+	return frames, nil
+}
+
+// DrawSequenceGIF encodes the frames of RenderFrames as an animated GIF showing each frame for delayMs
+// milliseconds. All frames are rendered before anything is written, so an invalid move writes nothing.
+func (s *Skewb) DrawSequenceGIF(w io.Writer, moves string, delayMs int) error {
+	frames, err := s.RenderFrames(moves)
+
+	if err != nil {
+		return err
+	}
+
+	animation := gif.GIF{}
+
+	for _, frame := range frames {
+		paletted := image.NewPaletted(frame.Bounds(), palette.Plan9)
+		draw.Draw(paletted, paletted.Bounds(), frame, frame.Bounds().Min, draw.Src)
+		animation.Image = append(animation.Image, paletted)
+		animation.Delay = append(animation.Delay, delayMs/10)
+	}
+
+	return gif.EncodeAll(w, &animation)
+}
+
+// DrawGrid draws the nets of states row by row in a grid with cols columns into fileName.png, each cell the
+// size of the image Draw writes.
+func DrawGrid(fileName string, states []*Skewb, cols int) error {
+	if len(states) == 0 || cols < 1 {
+		return ErrGrid
+	}
+
+	if renderer == nil {
+		return ErrNoRenderer
+	}
+
+	stickers := []Sticker{}
+
+	for i, state := range states {
+		for _, sticker := range state.Geometry() {
+			stickers = append(stickers, sticker.translate(float64(i%cols*490), float64(i/cols*430)))
+		}
+	}
+
+	rows := (len(states) + cols - 1) / cols
+	image := renderer(stickers, min(cols, len(states))*490, rows*430, DefaultDrawStyle)
+	file, err := os.Create(fmt.Sprintf("%v.png", fileName))
+
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	return png.Encode(file, image)
+}
+
+// DrawFull writes all six faces unfolded into a cross to fileName.png: up above front, left, front, right
+// and back in a row, and down below front. Every face is drawn looking at it with up on top, and the up
+// and down faces with front towards the middle of the cross.
+func (s *Skewb) DrawFull(fileName string) error {
+	if renderer == nil {
+		return ErrNoRenderer
+	}
+
+	half := netFaceSize / 2
+	colors := s.faceletColors()
+	stickers := []Sticker{}
+
+	for face, origin := range netFaceOrigins {
+		facelets := faceletOrder[face*5 : face*5+5]
+		polygons := [5][][2]float64{
+			{{half, 0}, {netFaceSize, half}, {half, netFaceSize}, {0, half}},
+			{{0, 0}, {half, 0}, {0, half}},
+			{{netFaceSize, 0}, {netFaceSize, half}, {half, 0}},
+			{{netFaceSize, netFaceSize}, {half, netFaceSize}, {netFaceSize, half}},
+			{{0, netFaceSize}, {0, half}, {half, netFaceSize}},
+		}
+
+		for i, facelet := range facelets {
+			sticker := Sticker{Color: colors[facelet], Vertices: polygons[i]}
+			stickers = append(stickers, sticker.translate(10+origin[0]*netFaceSize, 10+origin[1]*netFaceSize))
+		}
+	}
+
+	image := renderer(stickers, int(4*netFaceSize)+20, int(3*netFaceSize)+20, DefaultDrawStyle)
+	file, err := os.Create(fmt.Sprintf("%v.png", fileName))
+
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	return png.Encode(file, image)
+}
+
+// DrawFace writes a square PNG of one face of the net, its center and the four corner stickers around it,
+// cropped to the face with a 10 pixel margin.
+func (s *Skewb) DrawFace(w io.Writer, face string) error {
+	center := slices.Index(centerNames, face)
+
+	if center == -1 {
+		return fmt.Errorf("%v %w", face, ErrFace)
+	}
+
+	if renderer == nil {
+		return ErrNoRenderer
+	}
+
+	faceIndex := strings.IndexByte(faceletFaces, centerLetters[center])
+	faceFacelets := faceletOrder[faceIndex*5 : faceIndex*5+5]
+	minimum, maximum := [2]float64{math.Inf(1), math.Inf(1)}, [2]float64{math.Inf(-1), math.Inf(-1)}
+
+	for _, facelet := range faceFacelets {
+		for _, point := range faceletPolygon(facelet) {
+			minimum = [2]float64{min(minimum[0], point[0]), min(minimum[1], point[1])}
+			maximum = [2]float64{max(maximum[0], point[0]), max(maximum[1], point[1])}
+		}
+	}
+
+	size := max(maximum[0]-minimum[0], maximum[1]-minimum[1])
+	dx, dy := 10-minimum[0]+(size-(maximum[0]-minimum[0]))/2, 10-minimum[1]+(size-(maximum[1]-minimum[1]))/2
+	colors := s.faceletColors()
+	stickers := make([]Sticker, len(faceFacelets))
+
+	for i, facelet := range faceFacelets {
+		stickers[i] = Sticker{Color: colors[facelet], Vertices: faceletPolygon(facelet)}.translate(dx, dy)
+	}
+
+	image := renderer(stickers, int(size)+20, int(size)+20, DefaultDrawStyle)
+
+	return png.Encode(w, image)
+}
+
+func faceletPolygon(facelet int) [][2]float64 {
+	if facelet >= 24 {
+		positions := skewbCenters[facelet-24].positions
+
+		return [][2]float64{positions.starting, positions.firstLine, positions.secondLine, positions.thirdLine}
+	}
+
+	c := skewbCorners[facelet/3]
+	positions := [3]cornerPositions{c.firstPositions, c.secondPositions, c.thirdPositions}[facelet%3]
+
+	return [][2]float64{positions.starting, positions.firstLine, positions.secondLine}
+}
+
+// StickerAt finds the sticker drawn at pixel (x, y) of the image written by Draw. Corners are named like the
+// keys of AllCornerColors with the layer being the index into their colors, centers like the keys of
+// AllCenterColors with layer 0. ok is false for points on the background.
+func (s *Skewb) StickerAt(x, y float64) (piece string, layer int, ok bool) {
+	point := [2]float64{x - 10, y - 10}
+
+	for facelet := range len(s.facelets) {
+		if !insidePolygon(point, faceletPolygon(facelet)) {
+			continue
+		}
+
+		if facelet >= 24 {
+			return centerNames[facelet-24], 0, true
+		}
+
+		return cornerNames[facelet/3], facelet % 3, true
+	}
+
+	return "", 0, false
+}
+
+// Geometry returns the stickers of the net as plain polygons so the cube can be drawn by another renderer.
+// The three stickers of the corners ufr, urb, ulf, ubl, drf, dbr, dfl and dlb come first, then the up,
+// front, right, back, left and down centers.
+func (s *Skewb) Geometry() []Sticker {
+	return geometry(s.faceletColors())
+}
+
+func geometry(colors [30]string) []Sticker {
+	stickers := make([]Sticker, len(colors))
+
+	for facelet, color := range colors {
+		stickers[facelet] = Sticker{Color: color, Vertices: faceletPolygon(facelet)}.translate(10, 10)
+	}
+
+	return stickers
+}
+
+// skewberColors reads the colors of any Skewber through its getters, in the facelet order of faceletColors.
+func skewberColors(s Skewber) [30]string {
+	colors := [30]string{}
+	corners := [8][3]string{s.GetUFRCornerColors(), s.GetURBCornerColors(), s.GetULFCornerColors(), s.GetUBLCornerColors(), s.GetDRFCornerColors(), s.GetDBRCornerColors(), s.GetDFLCornerColors(), s.GetDLBCornerColors()}
+	centers := [6]string{s.GetUpCenterColor(), s.GetFrontCenterColor(), s.GetRightCenterColor(), s.GetBackCenterColor(), s.GetLeftCenterColor(), s.GetDownCenterColor()}
+
+	for i, corner := range corners {
+		copy(colors[i*3:], corner[:])
+	}
+
+	copy(colors[24:], centers[:])
+
+	return colors
+}
+
+func (s Sticker) translate(dx, dy float64) Sticker {
+	vertices := make([][2]float64, len(s.Vertices))
+
+	for i, vertex := range s.Vertices {
+		vertices[i] = [2]float64{vertex[0] + dx, vertex[1] + dy}
+	}
+
+	return Sticker{Color: s.Color, Vertices: vertices}
+}
+
+// insidePolygon casts a ray from point to the right and counts the edges it crosses.
+func insidePolygon(point [2]float64, polygon [][2]float64) bool {
+	inside := false
+
+	for i := range polygon {
+		a, b := polygon[i], polygon[(i+1)%len(polygon)]
+
+		if (a[1] > point[1]) != (b[1] > point[1]) && point[0] < a[0]+(point[1]-a[1])*(b[0]-a[0])/(b[1]-a[1]) {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
+
+func DiffImages(a, b image.Image) (pixelsDiffering int, err error) {
+	if a.Bounds().Size() != b.Bounds().Size() {
+		return 0, ErrImageSize
+	}
+
+	aBounds, bBounds := a.Bounds(), b.Bounds()
+
+	for y := 0; y < aBounds.Dy(); y++ {
+		for x := 0; x < aBounds.Dx(); x++ {
+			aR, aG, aB, aA := a.At(aBounds.Min.X+x, aBounds.Min.Y+y).RGBA()
+			bR, bG, bB, bA := b.At(bBounds.Min.X+x, bBounds.Min.Y+y).RGBA()
+
+			if aR != bR || aG != bG || aB != bB || aA != bA {
+				pixelsDiffering++
+			}
+		}
+	}
+
+	return pixelsDiffering, nil
+}
@@ -0,0 +1,93 @@
+package skewb_test
+
+import (
+	"errors"
+	"image"
+	"path/filepath"
+	"testing"
+
+	"github.com/Rasek91/skewb"
+	"github.com/Rasek91/skewb/draw"
+)
+
+func TestRenderFrames(t *testing.T) {
+	for _, test := range []struct {
+		moves  string
+		frames int
+	}{
+		{moves: "", frames: 1},
+		{moves: "R", frames: 2},
+		{moves: "R U' x B", frames: 5},
+	} {
+		s := skewb.NewFromScheme(skewb.DefaultScheme())
+		frames, err := s.RenderFrames(test.moves)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(frames) != test.frames {
+			t.Errorf("%q: got %v frames, want %v", test.moves, len(frames), test.frames)
+		}
+
+		for i, frame := range frames {
+			if frame.Bounds() != image.Rect(0, 0, 490, 430) {
+				t.Errorf("%q: frame %v has bounds %v", test.moves, i, frame.Bounds())
+			}
+		}
+	}
+
+	s := skewb.NewFromScheme(skewb.DefaultScheme())
+
+	if _, err := s.RenderFrames("R Q"); err == nil {
+		t.Error("RenderFrames accepted an invalid move")
+	}
+}
+
+func TestDiffImages(t *testing.T) {
+	s := skewb.NewFromScheme(skewb.DefaultScheme())
+	frames, err := s.RenderFrames("R")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pixels, err := skewb.DiffImages(frames[0], frames[0]); err != nil || pixels != 0 {
+		t.Errorf("got %v pixels and %v comparing a render to itself, want 0", pixels, err)
+	}
+
+	if pixels, err := skewb.DiffImages(frames[0], frames[1]); err != nil || pixels == 0 {
+		t.Errorf("got %v pixels and %v comparing a render to a moved state, want some", pixels, err)
+	}
+
+	if _, err := skewb.DiffImages(frames[0], image.NewRGBA(image.Rect(0, 0, 10, 10))); !errors.Is(err, skewb.ErrImageSize) {
+		t.Errorf("got %v comparing images of different sizes, want ErrImageSize", err)
+	}
+}
+
+func BenchmarkDraw(b *testing.B) {
+	s := skewb.NewFromScheme(skewb.DefaultScheme())
+	s.ApplyWCAMoves("R U' B L R' U L' B x R U' B' L y2 U R' B L'")
+	fileName := filepath.Join(b.TempDir(), "skewb")
+
+	for b.Loop() {
+		if err := s.Draw(fileName); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestNoRenderer(t *testing.T) {
+	skewb.RegisterRenderer(nil)
+	defer skewb.RegisterRenderer(draw.Render)
+
+	s := skewb.New("U", "F", "R", "B", "L", "D")
+
+	if err := s.Draw(filepath.Join(t.TempDir(), "net")); !errors.Is(err, skewb.ErrNoRenderer) {
+		t.Errorf("got %v, want ErrNoRenderer", err)
+	}
+
+	if _, err := s.RenderFrames("R"); !errors.Is(err, skewb.ErrNoRenderer) {
+		t.Errorf("got %v from RenderFrames, want ErrNoRenderer", err)
+	}
+}
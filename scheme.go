@@ -0,0 +1,63 @@
+package skewb
+
+import "slices"
+
+// ColorScheme names the color of each center of a solved cube.
+type ColorScheme struct {
+	Up, Front, Right, Back, Left, Down string
+}
+
+// DefaultScheme returns the standard white up, green front scheme as hex colors.
+func DefaultScheme() ColorScheme {
+	return ColorScheme{
+		Up:    "#FFFFFFFF",
+		Front: "#00FF00FF",
+		Right: "#FF0000FF",
+		Back:  "#0000FFFF",
+		Left:  "#D67200FF",
+		Down:  "#FBFF00FF",
+	}
+}
+
+type Resetter interface {
+	ResetTo(scheme ColorScheme)
+}
+
+// ResetTo turns the cube back into the solved cube NewFromScheme(scheme) returns, with empty history and
+// stats, keeping the memory it already holds so a loop can reuse one cube instead of creating a new one.
+func (s *Skewb) ResetTo(scheme ColorScheme) {
+	colors := [6]string{scheme.Up, scheme.Front, scheme.Right, scheme.Back, scheme.Left, scheme.Down}
+
+	// The colors may be shared with clones of the cube, so they are replaced rather than overwritten.
+	if !slices.Equal(s.colors, colors[:]) {
+		s.colors = slices.Clone(colors[:])
+	}
+
+	s.facelets = solvedFacelets
+	s.history = s.history[:0]
+	s.undone = s.undone[:0]
+	s.stats = MoveStats{}
+}
+
+// NewFromScheme returns a solved cube colored by the scheme.
+func NewFromScheme(scheme ColorScheme) Skewb {
+	return Skewb{
+		facelets: solvedFacelets,
+		colors:   []string{scheme.Up, scheme.Front, scheme.Right, scheme.Back, scheme.Left, scheme.Down},
+	}
+}
+
+// ColorblindScheme returns a scheme built from the Okabe-Ito palette, whose colors stay apart for
+// deuteranopia and protanopia. Front is blue #0072B2, back is bluish green #009E73, right is reddish purple
+// #CC79A7, left is orange #E69F00 and down is a softer yellow #F0E442, so no two colors differ only in their
+// red and green content. Up stays white.
+func ColorblindScheme() ColorScheme {
+	return ColorScheme{
+		Up:    "#FFFFFFFF",
+		Front: "#0072B2FF",
+		Right: "#CC79A7FF",
+		Back:  "#009E73FF",
+		Left:  "#E69F00FF",
+		Down:  "#F0E442FF",
+	}
+}
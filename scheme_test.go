@@ -0,0 +1,57 @@
+package skewb
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestResetTo(t *testing.T) {
+	s := New("U", "F", "R", "B", "L", "D")
+	s.ApplyWCAMoves(scramble)
+	s.Undo()
+	clone := s.clone()
+	s.ResetTo(DefaultScheme())
+	solved := NewFromScheme(DefaultScheme())
+
+	if !s.ExactEqual(&solved) {
+		t.Error("the cube is not the solved cube of the scheme")
+	}
+
+	if len(s.History()) != 0 || s.Stats() != (MoveStats{}) {
+		t.Errorf("got history %q and stats %v, want them empty", s.History(), s.Stats())
+	}
+
+	if err := s.Redo(); !errors.Is(err, ErrEmptyRedo) {
+		t.Errorf("got %v, want ErrEmptyRedo", err)
+	}
+
+	if clone.DistinctColorCount() != 6 || clone.AllCenterColors()["up"] == DefaultScheme().Up {
+		t.Error("ResetTo changed the colors of a clone")
+	}
+
+	s.ApplyWCAMoves(scramble)
+
+	if allocations := testing.AllocsPerRun(100, func() { s.ResetTo(DefaultScheme()) }); allocations != 0 {
+		t.Errorf("got %v allocations resetting to the same scheme, want none", allocations)
+	}
+}
+
+func BenchmarkNewFromScheme(b *testing.B) {
+	b.ReportAllocs()
+
+	for b.Loop() {
+		s := NewFromScheme(DefaultScheme())
+		s.ApplyRubiskewbMoves("x y'")
+	}
+}
+
+// BenchmarkResetTo reuses one cube, so unlike BenchmarkNewFromScheme it only allocates to parse the moves.
+func BenchmarkResetTo(b *testing.B) {
+	b.ReportAllocs()
+	s := Skewb{}
+
+	for b.Loop() {
+		s.ResetTo(DefaultScheme())
+		s.ApplyRubiskewbMoves("x y'")
+	}
+}
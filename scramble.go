@@ -0,0 +1,266 @@
+package skewb
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"time"
+)
+
+type RandomApplier interface {
+	ApplyRandom(n int, r *rand.Rand) string
+}
+
+var (
+	ErrScrambleNotFound = errors.New("no scramble found with the requested properties")
+	ErrDistance         = errors.New("distance is not between 0 and God's number of the skewb")
+	ErrScrambleLength   = errors.New("scramble length can not be negative")
+
+	scrambleAttempts = 10000
+
+	wcaScrambleLength   = 11
+	wcaScrambleDistance = 7
+)
+
+// ScrambleRequiringFirstMove draws length move WCA scrambles from seed until every optimal solution of one
+// starts with m: m brings the state one move closer to solved in the distance table and no other U, R, B or
+// L turn does.
+func ScrambleRequiringFirstMove(m Move, length int, seed int64) (string, error) {
+	if !isWCAFaceMove(m) {
+		return "", fmt.Errorf("%v %w", m, ErrWCAMove)
+	}
+
+	initTable()
+
+	random := rand.New(rand.NewSource(seed))
+
+	for range scrambleAttempts {
+		scramble, err := randomScramble(random, length)
+
+		if err != nil {
+			return "", err
+		}
+
+		state := solvedFacelets
+
+		for _, move := range scramble {
+			state = state.apply(move)
+		}
+
+		if slices.Equal(optimalFirstMoves(state), []Move{m}) {
+			return joinMoves(scramble), nil
+		}
+	}
+
+	return "", ErrScrambleNotFound
+}
+
+// optimalFirstMoves returns the U, R, B and L turns that start an optimal solution of state.
+func optimalFirstMoves(state facelets) []Move {
+	distance := distances[tableIndex(state)]
+	moves := []Move{}
+
+	if distance == 0 {
+		return moves
+	}
+
+	for _, move := range WCAFaceMoves {
+		if distances[tableIndex(state.apply(move))] == distance-1 {
+			moves = append(moves, move)
+		}
+	}
+
+	return moves
+}
+
+// WCAScramble returns an 11 move scramble of uniformly chosen U, R, B and L turns with no two consecutive
+// turns of the same corner. Scrambles whose state the distance table can solve in fewer than 7 moves,
+// counted in U, R, B and L turns, are rejected and drawn again.
+func WCAScramble() string {
+	initTable()
+
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	for {
+		scramble, _ := randomScramble(random, wcaScrambleLength)
+		state := solvedFacelets
+
+		for _, move := range scramble {
+			state = state.apply(move)
+		}
+
+		if distances[tableIndex(state)] >= uint8(wcaScrambleDistance) {
+			return joinMoves(scramble)
+		}
+	}
+}
+
+// ScrambleWithSolution returns a random n move WCA scramble together with an optimal solution for it
+// from the distance table. A negative n returns an error wrapping ErrScrambleLength.
+func ScrambleWithSolution(n int) (scramble string, solution []Move, err error) {
+	initTable()
+
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
+	moves, err := randomScramble(random, n)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	state := solvedFacelets
+
+	for _, move := range moves {
+		state = state.apply(move)
+	}
+
+	solution, err = tableSolveFacelets(state)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	return joinMoves(moves), solution, nil
+}
+
+// ScrambleAtDistance draws a state uniformly from the states exactly d moves from solved in the distance
+// table and returns the inverse of its optimal solution, a d move scramble of U, R, B and L turns.
+func ScrambleAtDistance(d int) (string, error) {
+	if d < 0 || d > godsNumber {
+		return "", fmt.Errorf("%v %w", d, ErrDistance)
+	}
+
+	initTable()
+
+	count := 0
+
+	for _, distance := range distances {
+		if int(distance) == d {
+			count++
+		}
+	}
+
+	if count == 0 {
+		return "", ErrScrambleNotFound
+	}
+
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
+	target := random.Intn(count)
+
+	for i, distance := range distances {
+		if int(distance) != d {
+			continue
+		}
+
+		if target > 0 {
+			target--
+
+			continue
+		}
+
+		solution, err := tableSolveFacelets(tableState(i))
+
+		if err != nil {
+			return "", err
+		}
+
+		scramble := make([]Move, 0, len(solution))
+
+		for _, move := range slices.Backward(solution) {
+			scramble = append(scramble, inverseMove(move))
+		}
+
+		return joinMoves(scramble), nil
+	}
+
+	return "", ErrScrambleNotFound
+}
+
+// ApplyRandom applies n random U, R, B and L turns drawn from r, never turning the same corner twice in a
+// row, and returns them. The moves go through ApplyWCAMoves, so they are recorded in the history.
+func (s *Skewb) ApplyRandom(n int, r *rand.Rand) string {
+	scramble, _ := randomScramble(r, max(n, 0))
+	moves := joinMoves(scramble)
+	s.ApplyWCAMoves(moves)
+
+	return moves
+}
+
+func randomScramble(random *rand.Rand, length int) ([]Move, error) {
+	if length < 0 {
+		return nil, fmt.Errorf("%v %w", length, ErrScrambleLength)
+	}
+
+	scramble := make([]Move, 0, length)
+
+	for len(scramble) < length {
+		move := WCAFaceMoves[random.Intn(len(WCAFaceMoves))]
+
+		if len(scramble) > 0 && sameAxis(scramble[len(scramble)-1], move) {
+			continue
+		}
+
+		scramble = append(scramble, move)
+	}
+
+	return scramble, nil
+}
+
+func isWCAFaceMove(move Move) bool {
+	for _, wcaMove := range WCAFaceMoves {
+		if move == wcaMove {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ScrambleMaximizingFace returns, among random scrambles of length WCA face turns drawn from seed, the one
+// that moves the most stickers off the face named by color. The face is named by its letter, "U", "F", "R",
+// "B", "L" or "D", for a cube held in the WCA orientation, not by the color of any scheme; anything else
+// returns ErrColor.
+func ScrambleMaximizingFace(color string, length int, seed int64) (string, error) {
+	code := slices.Index([]string{"U", "F", "R", "B", "L", "D"}, color)
+
+	if code == -1 {
+		return "", fmt.Errorf("%v %w", color, ErrColor)
+	}
+
+	random := rand.New(rand.NewSource(seed))
+	best, bestDisplaced := []Move{}, -1
+
+	for range scrambleAttempts {
+		scramble, err := randomScramble(random, length)
+
+		if err != nil {
+			return "", err
+		}
+
+		state := solvedFacelets
+
+		for _, move := range scramble {
+			state = state.apply(move)
+		}
+
+		displaced := displacedFacelets(state, int8(code))
+
+		if displaced > bestDisplaced {
+			best, bestDisplaced = scramble, displaced
+		}
+	}
+
+	return joinMoves(best), nil
+}
+
+func displacedFacelets(state facelets, code int8) int {
+	count := 0
+
+	for i, solvedCode := range solvedFacelets {
+		if solvedCode == code && state[i] != code {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,106 @@
+package skewb
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestScrambleRequiringFirstMove(t *testing.T) {
+	for _, move := range WCAFaceMoves {
+		scramble, err := ScrambleRequiringFirstMove(move, 6, 1)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		s := New("U", "F", "R", "B", "L", "D")
+		s.ApplyWCAMoves(scramble)
+		_, distance := s.NearestSolved()
+
+		for _, first := range WCAFaceMoves {
+			next := s.clone()
+			next.ApplyWCAMoves(string(first))
+
+			if _, length := next.NearestSolved(); (length == distance-1) != (first == move) {
+				t.Errorf("%v: %q is %v moves from solved, %v moves after %v", move, scramble, distance, length, first)
+			}
+		}
+	}
+
+	if _, err := ScrambleRequiringFirstMove(X, 6, 1); !errors.Is(err, ErrWCAMove) {
+		t.Errorf("got %v for a rotation, want ErrWCAMove", err)
+	}
+
+	if _, err := ScrambleRequiringFirstMove(R, -1, 1); !errors.Is(err, ErrScrambleLength) {
+		t.Errorf("got %v for a negative length, want ErrScrambleLength", err)
+	}
+}
+
+func TestScrambleMaximizingFace(t *testing.T) {
+	for _, color := range []string{"U", "F", "D"} {
+		scramble, err := ScrambleMaximizingFace(color, 8, 1)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		code := int8(slices.Index([]string{"U", "F", "R", "B", "L", "D"}, color))
+		displaced := func(moves string) int {
+			s := New("U", "F", "R", "B", "L", "D")
+			s.ApplyWCAMoves(moves)
+
+			return displacedFacelets(s.facelets, code)
+		}
+
+		if maximized, trivial := displaced(scramble), displaced("R R'"); maximized <= trivial {
+			t.Errorf("%v: %q displaces %v stickers, a trivial scramble %v", color, scramble, maximized, trivial)
+		}
+	}
+
+	for _, color := range []string{"purple", "u", DefaultScheme().Up} {
+		if _, err := ScrambleMaximizingFace(color, 8, 1); !errors.Is(err, ErrColor) {
+			t.Errorf("%q: got %v, want ErrColor", color, err)
+		}
+	}
+
+	if _, err := ScrambleMaximizingFace("U", -1, 1); !errors.Is(err, ErrScrambleLength) {
+		t.Errorf("got %v for a negative length, want ErrScrambleLength", err)
+	}
+}
+
+func TestScrambleWithSolution(t *testing.T) {
+	for _, n := range []int{0, 1, 5, 11, 20} {
+		scramble, solution, err := ScrambleWithSolution(n)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		s := New("U", "F", "R", "B", "L", "D")
+
+		if err := s.ApplyWCAMoves(scramble); err != nil {
+			t.Fatal(err)
+		}
+
+		if length := len(splitMoves(scramble)); length != n {
+			t.Errorf("got a %v move scramble, want %v", length, n)
+		}
+
+		if _, optimal := s.NearestSolved(); len(solution) != optimal {
+			t.Errorf("%q: solution %v is not optimal, %v moves are enough", scramble, solution, optimal)
+		}
+
+		if err := s.ApplyWCAMoves(joinMoves(solution)); err != nil {
+			t.Fatal(err)
+		}
+
+		if !s.IsSolved() {
+			t.Errorf("%q: %v does not solve it", scramble, solution)
+		}
+	}
+
+	if _, _, err := ScrambleWithSolution(-1); !errors.Is(err, ErrScrambleLength) {
+		t.Errorf("got %v for a negative length, want ErrScrambleLength", err)
+	}
+}
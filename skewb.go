@@ -3,12 +3,12 @@ package skewb
 import (
 	"errors"
 	"fmt"
+	"image"
+	"image/color"
 	"image/png"
 	"os"
+	"slices"
 	"strings"
-
-	"github.com/tfriedel6/canvas"
-	"github.com/tfriedel6/canvas/backend/softwarebackend"
 )
 
 type Skewber interface {
@@ -26,6 +26,14 @@ type Drawer interface {
 	Draw(fileName string) error
 }
 
+type StyledDrawer interface {
+	DrawStyled(fileName string, style DrawStyle) error
+}
+
+type ComparisonDrawer interface {
+	DrawComparison(fileName string, other Skewber) error
+}
+
 type MovesApplier interface {
 	ApplyWCAMoves(wcaMoves string) error
 	ApplyRubiskewbMoves(rubiskewbMoves string) error
@@ -35,18 +43,38 @@ type WCAMovesApplier interface {
 	ApplyWCAMoves(wcaMoves string) error
 }
 
+type LenientWCAMovesApplier interface {
+	ApplyWCAMovesLenient(wcaMoves string) error
+}
+
+type SimplifiedWCAMovesApplier interface {
+	ApplyWCAMovesSimplified(wcaMoves string) (applied string, err error)
+}
+
 type RubiskewbMovesApplier interface {
 	ApplyRubiskewbMoves(rubiskewbMoves string) error
 }
 
+type RelativeColorsGetter interface {
+	RelativeColors() [6][5]int
+}
+
 type CenterDowner interface {
 	CenterDown(color string) error
 }
 
+type FaceCenterDowner interface {
+	CenterDownFace(face string) error
+}
+
 type Equaler interface {
 	Equal(other Skewber) bool
 }
 
+type PatternEqualer interface {
+	PatternEqual(other Skewber) bool
+}
+
 type ExactEqualer interface {
 	ExactEqual(other Skewber) bool
 }
@@ -64,6 +92,19 @@ type FullMirrorer interface {
 	FullMirror(other Skewber) bool
 }
 
+type MirrorErrer interface {
+	OneLayerMirrorErr(other Skewber, layerColor string) (bool, error)
+	FullMirrorErr(other Skewber) (bool, error)
+}
+
+type PlaneMirrorer interface {
+	Mirror(plane string) Skewb
+}
+
+type LayerMirrorer interface {
+	MirrorLayer(color string) (Skewb, error)
+}
+
 type CornerColorsGetter interface {
 	GetUFRCornerColors() [3]string
 	GetURBCornerColors() [3]string
@@ -84,37 +125,52 @@ type CenterColorGetter interface {
 	GetDownCenterColor() string
 }
 
+// DrawStyle sets how the outlines of the stickers are drawn. StrokeColor takes the same color strings as
+// the stickers. A nil Background leaves the image transparent behind the net.
+type DrawStyle struct {
+	StrokeColor string
+	StrokeWidth float64
+	HideStrokes bool
+	Background  color.Color
+}
+
+// Skewb is not safe for concurrent use. Applying moves, undoing them and CenterDown change it in place, and
+// the comparisons read it while reorienting a copy, so a cube shared between goroutines, including one passed
+// as the other argument of a comparison, needs its own locking.
+//
+// The zero Skewb has no colors, so its getters return empty strings; New or ResetTo makes a usable cube.
 type Skewb struct {
-	ufr corner
-	urb corner
-	ulf corner
-	ubl corner
-	drf corner
-	dbr corner
-	dfl corner
-	dlb corner
-
-	up    center
-	front center
-	right center
-	back  center
-	left  center
-	down  center
+	facelets facelets
+	colors   []string
+
+	history []appliedMove
+	undone  []appliedMove
+	stats   MoveStats
+}
+
+type facelets [30]int8
+
+// moveError is an error about a move of one notation that also matches the error of another notation, so
+// ErrRubiskewbMove is ErrWCAMove for errors.Is.
+type moveError struct {
+	message string
+	wrapped error
+}
+
+func (e *moveError) Error() string {
+	return e.message
+}
+
+func (e *moveError) Unwrap() error {
+	return e.wrapped
 }
 
 type corner struct {
-	colors          CornerColors
 	firstPositions  cornerPositions
 	secondPositions cornerPositions
 	thirdPositions  cornerPositions
 }
 
-type CornerColors struct {
-	first  string
-	second string
-	third  string
-}
-
 type cornerPositions struct {
 	starting   [2]float64
 	firstLine  [2]float64
@@ -122,7 +178,6 @@ type cornerPositions struct {
 }
 
 type center struct {
-	color     string
 	positions centerPositions
 }
 
@@ -138,22 +193,31 @@ type Move string
 var (
 	U            Move = "U"
 	UPrime       Move = "U'"
+	U2           Move = "U2"
 	R            Move = "R"
 	RPrime       Move = "R'"
+	R2           Move = "R2"
 	LittleR      Move = "r"
 	LittleRPrime Move = "r'"
+	LittleR2     Move = "r2"
 	B            Move = "B"
 	BPrime       Move = "B'"
+	B2           Move = "B2"
 	LittleB      Move = "b"
 	LittleBPrime Move = "b'"
+	LittleB2     Move = "b2"
 	L            Move = "L"
 	LPrime       Move = "L'"
+	L2           Move = "L2"
 	LittleL      Move = "l"
 	LittleLPrime Move = "l'"
+	LittleL2     Move = "l2"
 	F            Move = "F"
 	FPrime       Move = "F'"
+	F2           Move = "F2"
 	LittleF      Move = "f"
 	LittleFPrime Move = "f'"
+	LittleF2     Move = "f2"
 
 	X      Move = "x"
 	XPrime Move = "x'"
@@ -165,26 +229,61 @@ var (
 	ZPrime Move = "z'"
 	Z2     Move = "z2"
 
-	ErrWCAMove = errors.New("wca move is not supported; valid types are: \"U\", \"U'\" \"R\", \"R'\" \"B\", \"B'\" \"L\", \"L'\", \"x\", \"x'\", \"x2\", \"y\", \"y'\", \"y2\", \"z\", \"z'\", \"z2\"")
-	ErrColor   = errors.New("color is not part of Skewb")
+	// WCAFaceMoves are the WCA face turns, WCARotations the whole cube rotations both notations share and
+	// RubiskewbFaceMoves the Rubiskewb face turns. The "2" turns, the same as the inverse on a skewb, are
+	// only listed in AllMoves, every move ParseMoves accepts.
+	WCAFaceMoves       = []Move{U, UPrime, R, RPrime, B, BPrime, L, LPrime}
+	WCARotations       = []Move{X, XPrime, X2, Y, YPrime, Y2, Z, ZPrime, Z2}
+	RubiskewbFaceMoves = []Move{R, RPrime, LittleR, LittleRPrime, B, BPrime, LittleB, LittleBPrime, L, LPrime, LittleL, LittleLPrime, F, FPrime, LittleF, LittleFPrime}
+	AllMoves           = []Move{
+		U, UPrime, U2, R, RPrime, R2, LittleR, LittleRPrime, LittleR2, B, BPrime, B2, LittleB, LittleBPrime, LittleB2,
+		L, LPrime, L2, LittleL, LittleLPrime, LittleL2, F, FPrime, F2, LittleF, LittleFPrime, LittleF2,
+		X, XPrime, X2, Y, YPrime, Y2, Z, ZPrime, Z2,
+	}
+
+	ErrWCAMove             = errors.New("wca move is not supported; valid types are: \"U\", \"U'\", \"U2\", \"R\", \"R'\", \"R2\", \"B\", \"B'\", \"B2\", \"L\", \"L'\", \"L2\", \"x\", \"x'\", \"x2\", \"y\", \"y'\", \"y2\", \"z\", \"z'\", \"z2\"")
+	ErrRubiskewbMove error = &moveError{message: "rubiskewb move is not supported; valid types are: \"R\", \"R'\", \"R2\", \"r\", \"r'\", \"r2\", \"B\", \"B'\", \"B2\", \"b\", \"b'\", \"b2\", \"L\", \"L'\", \"L2\", \"l\", \"l'\", \"l2\", \"F\", \"F'\", \"F2\", \"f\", \"f'\", \"f2\", \"x\", \"x'\", \"x2\", \"y\", \"y'\", \"y2\", \"z\", \"z'\", \"z2\"", wrapped: ErrWCAMove}
+	ErrColor               = errors.New("color is not part of Skewb")
 
-	clockwise, itsY, equal             = true, true, true
-	counterClockwise, itsntY, notEqual = false, false, false
+	equal, rubiskewbNotation = true, true
+	notEqual, wcaNotation    = false, false
+
+	solvedFacelets = facelets{0, 1, 2, 0, 2, 3, 0, 4, 1, 0, 3, 4, 5, 2, 1, 5, 3, 2, 5, 1, 4, 5, 4, 3, 0, 1, 2, 3, 4, 5}
 
 	layerCornerColor  = 0
 	firstCornerColor  = 1
 	secondCornerColor = 2
 	otherCornerColor  = -1
+
+	comparisonGap = 60
+
+	DefaultDrawStyle = DrawStyle{StrokeColor: "#000000FF", StrokeWidth: 3.0}
 )
 
-func New(upColor, frontColor, rightColor, backColor, leftColor, downColor string) Skewb {
-	return Skewb{
-		ufr: corner{
-			colors: CornerColors{
-				first:  upColor,
-				second: frontColor,
-				third:  rightColor,
-			},
+const (
+	ufrCorner = iota
+	urbCorner
+	ulfCorner
+	ublCorner
+	drfCorner
+	dbrCorner
+	dflCorner
+	dlbCorner
+)
+
+const (
+	upCenter = iota
+	frontCenter
+	rightCenter
+	backCenter
+	leftCenter
+	downCenter
+)
+
+var (
+	// Positions for drawing: https://github.com/AnnikaStein/SkewbPage/blob/7ced702e91ed90de86f3020403c0c17ce484f4ac/SkewbSkills/skewbskillsscripts.js#L1750
+	skewbCorners = [8]corner{
+		ufrCorner: {
 			firstPositions: cornerPositions{
 				starting:   [2]float64{180, 90},
 				firstLine:  [2]float64{300, 90},
@@ -201,12 +300,7 @@ func New(upColor, frontColor, rightColor, backColor, leftColor, downColor string
 				secondLine: [2]float64{240, 195},
 			},
 		},
-		urb: corner{
-			colors: CornerColors{
-				first:  upColor,
-				second: rightColor,
-				third:  backColor,
-			},
+		urbCorner: {
 			firstPositions: cornerPositions{
 				starting:   [2]float64{300, 30},
 				firstLine:  [2]float64{360, 60},
@@ -223,12 +317,7 @@ func New(upColor, frontColor, rightColor, backColor, leftColor, downColor string
 				secondLine: [2]float64{360, 135},
 			},
 		},
-		ulf: corner{
-			colors: CornerColors{
-				first:  upColor,
-				second: leftColor,
-				third:  frontColor,
-			},
+		ulfCorner: {
 			firstPositions: cornerPositions{
 				starting:   [2]float64{120, 60},
 				firstLine:  [2]float64{180, 30},
@@ -245,12 +334,7 @@ func New(upColor, frontColor, rightColor, backColor, leftColor, downColor string
 				secondLine: [2]float64{120, 135},
 			},
 		},
-		ubl: corner{
-			colors: CornerColors{
-				first:  upColor,
-				second: backColor,
-				third:  leftColor,
-			},
+		ublCorner: {
 			firstPositions: cornerPositions{
 				starting:   [2]float64{180, 30},
 				firstLine:  [2]float64{240, 0},
@@ -267,12 +351,7 @@ func New(upColor, frontColor, rightColor, backColor, leftColor, downColor string
 				secondLine: [2]float64{0, 75},
 			},
 		},
-		drf: corner{
-			colors: CornerColors{
-				first:  downColor,
-				second: rightColor,
-				third:  frontColor,
-			},
+		drfCorner: {
 			firstPositions: cornerPositions{
 				starting:   [2]float64{180, 240},
 				firstLine:  [2]float64{240, 270},
@@ -289,12 +368,7 @@ func New(upColor, frontColor, rightColor, backColor, leftColor, downColor string
 				secondLine: [2]float64{180, 240},
 			},
 		},
-		dbr: corner{
-			colors: CornerColors{
-				first:  downColor,
-				second: backColor,
-				third:  rightColor,
-			},
+		dbrCorner: {
 			firstPositions: cornerPositions{
 				starting:   [2]float64{240, 345},
 				firstLine:  [2]float64{240, 420},
@@ -311,12 +385,7 @@ func New(upColor, frontColor, rightColor, backColor, leftColor, downColor string
 				secondLine: [2]float64{360, 210},
 			},
 		},
-		dfl: corner{
-			colors: CornerColors{
-				first:  downColor,
-				second: frontColor,
-				third:  leftColor,
-			},
+		dflCorner: {
 			firstPositions: cornerPositions{
 				starting:   [2]float64{120, 210},
 				firstLine:  [2]float64{180, 240},
@@ -333,12 +402,7 @@ func New(upColor, frontColor, rightColor, backColor, leftColor, downColor string
 				secondLine: [2]float64{60, 180},
 			},
 		},
-		dlb: corner{
-			colors: CornerColors{
-				first:  downColor,
-				second: leftColor,
-				third:  backColor,
-			},
+		dlbCorner: {
 			firstPositions: cornerPositions{
 				starting:   [2]float64{120, 285},
 				firstLine:  [2]float64{180, 390},
@@ -355,9 +419,10 @@ func New(upColor, frontColor, rightColor, backColor, leftColor, downColor string
 				secondLine: [2]float64{480, 150},
 			},
 		},
+	}
 
-		up: center{
-			color: upColor,
+	skewbCenters = [6]center{
+		upCenter: {
 			positions: centerPositions{
 				starting:   [2]float64{180, 90},
 				firstLine:  [2]float64{180, 30},
@@ -365,8 +430,7 @@ func New(upColor, frontColor, rightColor, backColor, leftColor, downColor string
 				thirdLine:  [2]float64{300, 90},
 			},
 		},
-		front: center{
-			color: frontColor,
+		frontCenter: {
 			positions: centerPositions{
 				starting:   [2]float64{120, 135},
 				firstLine:  [2]float64{180, 90},
@@ -374,8 +438,7 @@ func New(upColor, frontColor, rightColor, backColor, leftColor, downColor string
 				thirdLine:  [2]float64{180, 240},
 			},
 		},
-		right: center{
-			color: rightColor,
+		rightCenter: {
 			positions: centerPositions{
 				starting:   [2]float64{240, 195},
 				firstLine:  [2]float64{300, 90},
@@ -383,8 +446,7 @@ func New(upColor, frontColor, rightColor, backColor, leftColor, downColor string
 				thirdLine:  [2]float64{300, 240},
 			},
 		},
-		back: center{
-			color: backColor,
+		backCenter: {
 			positions: centerPositions{
 				starting:   [2]float64{360, 135},
 				firstLine:  [2]float64{420, 30},
@@ -392,8 +454,7 @@ func New(upColor, frontColor, rightColor, backColor, leftColor, downColor string
 				thirdLine:  [2]float64{420, 180},
 			},
 		},
-		left: center{
-			color: leftColor,
+		leftCenter: {
 			positions: centerPositions{
 				starting:   [2]float64{0, 75},
 				firstLine:  [2]float64{60, 30},
@@ -401,8 +462,7 @@ func New(upColor, frontColor, rightColor, backColor, leftColor, downColor string
 				thirdLine:  [2]float64{60, 180},
 			},
 		},
-		down: center{
-			color: downColor,
+		downCenter: {
 			positions: centerPositions{
 				starting:   [2]float64{180, 240},
 				firstLine:  [2]float64{240, 345},
@@ -411,301 +471,217 @@ func New(upColor, frontColor, rightColor, backColor, leftColor, downColor string
 			},
 		},
 	}
+)
+
+func New(upColor, frontColor, rightColor, backColor, leftColor, downColor string) Skewb {
+	return NewFromScheme(ColorScheme{
+		Up:    upColor,
+		Front: frontColor,
+		Right: rightColor,
+		Back:  backColor,
+		Left:  leftColor,
+		Down:  downColor,
+	})
 }
 
-func (s *Skewb) Draw(fileName string) error {
-	backend := softwarebackend.New(490, 430)
-	cv := canvas.New(backend)
-	image := cv.GetImageData(0, 0, 490, 430)
+func (s *Skewb) clone() Skewb {
+	clone := *s
+	clone.history = nil
+	clone.undone = nil
+	clone.stats = MoveStats{}
 
-	// Positions for drawing: https://github.com/AnnikaStein/SkewbPage/blob/7ced702e91ed90de86f3020403c0c17ce484f4ac/SkewbSkills/skewbskillsscripts.js#L1750
-	cv.Translate(10, 10)
-	cv.SetStrokeStyle("#000000FF")
-	cv.SetLineWidth(3.0)
-	cv.SetLineJoin(canvas.Round)
-	cv.SetLineCap(canvas.Round)
-
-	s.ufr.draw(cv)
-	s.urb.draw(cv)
-	s.ulf.draw(cv)
-	s.ubl.draw(cv)
-	s.drf.draw(cv)
-	s.dbr.draw(cv)
-	s.dfl.draw(cv)
-	s.dlb.draw(cv)
-	s.up.draw(cv)
-
-	s.front.draw(cv)
-	s.right.draw(cv)
-	s.back.draw(cv)
-	s.left.draw(cv)
-	s.down.draw(cv)
-
-	cv.Translate(-10, -10)
+	return clone
+}
 
-	file, err := os.Create(fmt.Sprintf("%v.png", fileName))
+// Draw writes the net of the cube to fileName.png. Like every drawing method it needs a renderer, which the
+// draw subpackage registers when imported, and returns ErrNoRenderer otherwise.
+func (s *Skewb) Draw(fileName string) error {
+	return s.DrawStyled(fileName, DefaultDrawStyle)
+}
+
+func (s *Skewb) DrawStyled(fileName string, style DrawStyle) error {
+	image, err := s.render(style)
 
 	if err != nil {
 		return err
 	}
 
-	err = png.Encode(file, image)
+	file, err := os.Create(fmt.Sprintf("%v.png", fileName))
 
 	if err != nil {
 		return err
 	}
 
-	return nil
-}
-
-func (c *corner) draw(cv *canvas.Canvas) {
-	c.drawFirstLayer(cv)
-	c.drawSecondLayer(cv)
-	c.drawThirdLayer(cv)
-}
-
-func (c *corner) drawFirstLayer(cv *canvas.Canvas) {
-	cv.SetFillStyle(c.colors.first)
-	cv.BeginPath()
-	cv.MoveTo(c.firstPositions.starting[0], c.firstPositions.starting[1])
-	cv.LineTo(c.firstPositions.firstLine[0], c.firstPositions.firstLine[1])
-	cv.LineTo(c.firstPositions.secondLine[0], c.firstPositions.secondLine[1])
-	cv.LineTo(c.firstPositions.starting[0], c.firstPositions.starting[1])
-	cv.ClosePath()
-	cv.Fill()
-	cv.Stroke()
-}
-
-func (c *corner) drawSecondLayer(cv *canvas.Canvas) {
-	cv.SetFillStyle(c.colors.second)
-	cv.BeginPath()
-	cv.MoveTo(c.secondPositions.starting[0], c.secondPositions.starting[1])
-	cv.LineTo(c.secondPositions.firstLine[0], c.secondPositions.firstLine[1])
-	cv.LineTo(c.secondPositions.secondLine[0], c.secondPositions.secondLine[1])
-	cv.LineTo(c.secondPositions.starting[0], c.secondPositions.starting[1])
-	cv.ClosePath()
-	cv.Fill()
-	cv.Stroke()
-}
-
-func (c *corner) drawThirdLayer(cv *canvas.Canvas) {
-	cv.SetFillStyle(c.colors.third)
-	cv.BeginPath()
-	cv.MoveTo(c.thirdPositions.starting[0], c.thirdPositions.starting[1])
-	cv.LineTo(c.thirdPositions.firstLine[0], c.thirdPositions.firstLine[1])
-	cv.LineTo(c.thirdPositions.secondLine[0], c.thirdPositions.secondLine[1])
-	cv.LineTo(c.thirdPositions.starting[0], c.thirdPositions.starting[1])
-	cv.ClosePath()
-	cv.Fill()
-	cv.Stroke()
-}
-
-func (c *center) draw(cv *canvas.Canvas) {
-	cv.SetFillStyle(c.color)
-	cv.BeginPath()
-	cv.MoveTo(c.positions.starting[0], c.positions.starting[1])
-	cv.LineTo(c.positions.firstLine[0], c.positions.firstLine[1])
-	cv.LineTo(c.positions.secondLine[0], c.positions.secondLine[1])
-	cv.LineTo(c.positions.thirdLine[0], c.positions.thirdLine[1])
-	cv.LineTo(c.positions.starting[0], c.positions.starting[1])
-	cv.ClosePath()
-	cv.Fill()
-	cv.Stroke()
-}
+	defer file.Close()
 
-func (s *Skewb) ApplyWCAMoves(wcaMoves string) error {
-	for _, m := range strings.Split(wcaMoves, " ") {
-		var isClockwise bool
-		move := Move(m)
-
-		switch move {
-		case U, R, B, L, X, X2, Y, Y2, Z, Z2:
-			isClockwise = clockwise
-		case UPrime, RPrime, BPrime, LPrime, XPrime, YPrime, ZPrime:
-			isClockwise = counterClockwise
-		default:
-			return fmt.Errorf("%v %v", move, ErrWCAMove)
-		}
+	return png.Encode(file, image)
+}
 
-		switch move {
-		case U, UPrime:
-			applyMove(&s.ubl, &s.dlb, &s.urb, &s.ulf, &s.up, &s.left, &s.back, isClockwise)
-		case R, RPrime:
-			applyMove(&s.dbr, &s.drf, &s.urb, &s.dlb, &s.right, &s.back, &s.down, isClockwise)
-		case B, BPrime:
-			applyMove(&s.dlb, &s.dbr, &s.ubl, &s.dfl, &s.back, &s.left, &s.down, isClockwise)
-		case L, LPrime:
-			applyMove(&s.dfl, &s.dlb, &s.ulf, &s.drf, &s.front, &s.down, &s.left, isClockwise)
-		case X, XPrime, X2:
-			applyRotation(&s.ufr, &s.urb, &s.dbr, &s.drf, &s.ulf, &s.ubl, &s.dlb, &s.dfl, &s.front, &s.up, &s.back, &s.down, isClockwise, move == X2, itsntY)
-		case Y, YPrime, Y2:
-			applyRotation(&s.ufr, &s.ulf, &s.ubl, &s.urb, &s.drf, &s.dfl, &s.dlb, &s.dbr, &s.front, &s.left, &s.back, &s.right, isClockwise, move == Y2, itsY)
-		case Z, ZPrime, Z2:
-			applyRotation(&s.ulf, &s.ufr, &s.drf, &s.dfl, &s.ubl, &s.urb, &s.dbr, &s.dlb, &s.up, &s.right, &s.down, &s.left, isClockwise, move == Z2, itsntY)
-		}
+func (s *Skewb) render(style DrawStyle) (*image.RGBA, error) {
+	if renderer == nil {
+		return nil, ErrNoRenderer
 	}
 
-	return nil
+	return renderer(s.Geometry(), 490, 430, style), nil
 }
 
-func (s *Skewb) ApplyRubiskewbMoves(rubiskewbMoves string) error {
-	for _, m := range strings.Split(rubiskewbMoves, " ") {
-		var isClockwise bool
-		move := Move(m)
-
-		switch move {
-		case R, LittleR, B, LittleB, L, LittleL, F, LittleF, X, X2, Y, Y2, Z, Z2:
-			isClockwise = clockwise
-		case RPrime, LittleRPrime, BPrime, LittleBPrime, LPrime, LittleLPrime, FPrime, LittleFPrime, XPrime, YPrime, ZPrime:
-			isClockwise = counterClockwise
-		default:
-			return fmt.Errorf("%v %v", move, ErrWCAMove)
-		}
+// DrawComparison draws the cube and other side by side in one image, other on the right after a gap.
+func (s *Skewb) DrawComparison(fileName string, other Skewber) error {
+	if renderer == nil {
+		return ErrNoRenderer
+	}
 
-		switch move {
-		case R, RPrime:
-			applyMove(&s.urb, &s.ufr, &s.ubl, &s.dbr, &s.right, &s.up, &s.back, isClockwise)
-		case LittleR, LittleRPrime:
-			applyMove(&s.dbr, &s.drf, &s.urb, &s.dlb, &s.right, &s.back, &s.down, isClockwise)
-		case B, BPrime:
-			applyMove(&s.ubl, &s.dlb, &s.urb, &s.ulf, &s.up, &s.left, &s.back, isClockwise)
-		case LittleB, LittleBPrime:
-			applyMove(&s.dlb, &s.dbr, &s.ubl, &s.dfl, &s.back, &s.left, &s.down, isClockwise)
-		case L, LPrime:
-			applyMove(&s.ulf, &s.ubl, &s.ufr, &s.dfl, &s.up, &s.front, &s.left, isClockwise)
-		case LittleL, LittleLPrime:
-			applyMove(&s.dfl, &s.dlb, &s.ulf, &s.drf, &s.front, &s.down, &s.left, isClockwise)
-		case F, FPrime:
-			applyMove(&s.ufr, &s.ulf, &s.urb, &s.drf, &s.front, &s.up, &s.right, isClockwise)
-		case LittleF, LittleFPrime:
-			applyMove(&s.drf, &s.dfl, &s.ufr, &s.dbr, &s.front, &s.right, &s.down, isClockwise)
-		case X, XPrime, X2:
-			applyRotation(&s.ufr, &s.urb, &s.dbr, &s.drf, &s.ulf, &s.ubl, &s.dlb, &s.dfl, &s.front, &s.up, &s.back, &s.down, isClockwise, move == X2, itsntY)
-		case Y, YPrime, Y2:
-			applyRotation(&s.ufr, &s.ulf, &s.ubl, &s.urb, &s.drf, &s.dfl, &s.dlb, &s.dbr, &s.front, &s.left, &s.back, &s.right, isClockwise, move == Y2, itsY)
-		case Z, ZPrime, Z2:
-			applyRotation(&s.ulf, &s.ufr, &s.drf, &s.dfl, &s.ubl, &s.urb, &s.dbr, &s.dlb, &s.up, &s.right, &s.down, &s.left, isClockwise, move == Z2, itsntY)
-		}
+	stickers := s.Geometry()
+
+	for _, sticker := range geometry(skewberColors(other)) {
+		stickers = append(stickers, sticker.translate(float64(490+comparisonGap), 0))
 	}
 
-	return nil
-}
+	image := renderer(stickers, 2*490+comparisonGap, 430, DefaultDrawStyle)
+	file, err := os.Create(fmt.Sprintf("%v.png", fileName))
 
-func applyMove(rotationCenter, firstCorner, secondCorner, thirdCorner *corner, firstCenter, secondCenter, thirdCenter *center, isClockwise bool) {
-	rotationCenter.rotate(isClockwise)
-	firstCorner.rotate(!isClockwise)
-	secondCorner.rotate(!isClockwise)
-	thirdCorner.rotate(!isClockwise)
+	if err != nil {
+		return err
+	}
 
-	rotateThreeCenters(firstCenter, secondCenter, thirdCenter, isClockwise)
+	defer file.Close()
 
-	rotateThreeCorners(firstCorner, secondCorner, thirdCorner, isClockwise)
+	return png.Encode(file, image)
 }
 
-func rotateThreeCenters(first, second, third *center, isClockwise bool) {
-	if isClockwise == clockwise {
-		first.color, second.color, third.color = third.color, first.color, second.color
-	} else {
-		first.color, second.color, third.color = second.color, third.color, first.color
-	}
+func (s *Skewb) ApplyWCAMoves(wcaMoves string) error {
+	return s.applyMoves(wcaMoves, wcaMovePermutations, wcaNotation, ErrWCAMove)
 }
 
-func rotateThreeCorners(first, second, third *corner, isClockwise bool) {
-	if isClockwise == clockwise {
-		first.colors, second.colors, third.colors = third.colors, first.colors, second.colors
-	} else {
-		first.colors, second.colors, third.colors = second.colors, third.colors, first.colors
+// ApplyWCAMovesLenient upper-cases u, r, b and l before applying the moves as WCA notation, so typing the
+// face letters in lower case works. Lower case letters are wide turns in Rubiskewb notation, so a Rubiskewb
+// sequence passed here is applied as a different, valid WCA sequence instead of being rejected.
+func (s *Skewb) ApplyWCAMovesLenient(wcaMoves string) error {
+	tokens := splitMoves(wcaMoves)
+
+	for i, token := range tokens {
+		if strings.ContainsAny(token[:1], "urbl") {
+			tokens[i] = strings.ToUpper(token[:1]) + token[1:]
+		}
 	}
+
+	return s.ApplyWCAMoves(strings.Join(tokens, " "))
 }
 
-func (c *corner) rotate(isClockwise bool) {
-	if isClockwise == clockwise {
-		c.colors.first, c.colors.second, c.colors.third = c.colors.second, c.colors.third, c.colors.first
-	} else {
-		c.colors.first, c.colors.second, c.colors.third = c.colors.third, c.colors.first, c.colors.second
+// ApplyWCAMovesSimplified applies the moves like ApplyWCAMoves and returns them with adjacent turns of the
+// same face or axis merged or canceled, a shorter sequence with the same effect. The history still records
+// every move as given. Nothing is returned for a sequence with an invalid move.
+func (s *Skewb) ApplyWCAMovesSimplified(wcaMoves string) (applied string, err error) {
+	if err := s.ApplyWCAMoves(wcaMoves); err != nil {
+		return "", err
+	}
+
+	parsed, err := parseNotationMoves(wcaMoves, wcaMovePermutations, ErrWCAMove)
+
+	if err != nil {
+		return "", err
 	}
+
+	return joinMoves(simplifyMoves(parsed)), nil
 }
 
-func applyRotation(firstCorner, secondCorner, thirdCorner, fourthCorner, fifthCorner, sixthCorner, seventhCorner, eighthCorner *corner, firstCenter, secondCenter, thirdCenter, fourthCenter *center, isClockwise, isDouble, isY bool) {
-	rotateFourCenters(firstCenter, secondCenter, thirdCenter, fourthCenter, isClockwise)
+func (s *Skewb) ApplyRubiskewbMoves(rubiskewbMoves string) error {
+	return s.applyMoves(rubiskewbMoves, rubiskewbMovePermutations, rubiskewbNotation, ErrRubiskewbMove)
+}
 
-	rotateFourCorners(firstCorner, secondCorner, thirdCorner, fourthCorner, isClockwise)
-	rotateFourCorners(fifthCorner, sixthCorner, seventhCorner, eighthCorner, isClockwise)
+func (s *Skewb) applyMoves(moves string, permutations map[Move]facelets, isRubiskewb bool, errMove error) error {
+	parsed, err := parseNotationMoves(moves, permutations, errMove)
 
-	if !isY {
-		firstCorner.rotate(counterClockwise)
-		secondCorner.rotate(clockwise)
-		thirdCorner.rotate(counterClockwise)
-		fourthCorner.rotate(clockwise)
-		fifthCorner.rotate(clockwise)
-		sixthCorner.rotate(counterClockwise)
-		seventhCorner.rotate(clockwise)
-		eighthCorner.rotate(counterClockwise)
+	for _, move := range parsed {
+		s.facelets = s.facelets.permute(permutations[move])
+		s.record(appliedMove{move: move, isRubiskewb: isRubiskewb})
 	}
 
-	if isDouble {
-		rotateFourCenters(firstCenter, secondCenter, thirdCenter, fourthCenter, isClockwise)
-
-		rotateFourCorners(firstCorner, secondCorner, thirdCorner, fourthCorner, isClockwise)
-		rotateFourCorners(fifthCorner, sixthCorner, seventhCorner, eighthCorner, isClockwise)
-
-		if !isY {
-			firstCorner.rotate(counterClockwise)
-			secondCorner.rotate(clockwise)
-			thirdCorner.rotate(counterClockwise)
-			fourthCorner.rotate(clockwise)
-			fifthCorner.rotate(clockwise)
-			sixthCorner.rotate(counterClockwise)
-			seventhCorner.rotate(clockwise)
-			eighthCorner.rotate(counterClockwise)
-		}
-	}
+	return err
 }
 
-func rotateFourCenters(first, second, third, fourth *center, isClockwise bool) {
-	if isClockwise == clockwise {
-		first.color, second.color, third.color, fourth.color = fourth.color, first.color, second.color, third.color
-	} else {
-		first.color, second.color, third.color, fourth.color = second.color, third.color, fourth.color, first.color
+func parseNotationMoves(moves string, permutations map[Move]facelets, errMove error) ([]Move, error) {
+	tokens := splitMoves(moves)
+	parsed := make([]Move, 0, len(tokens))
+
+	for _, m := range tokens {
+		move := normalizeMove(Move(m))
+
+		if _, ok := permutations[move]; !ok {
+			return parsed, fmt.Errorf("%v %w", m, errMove)
+		}
+
+		parsed = append(parsed, move)
 	}
+
+	return parsed, nil
 }
 
-func rotateFourCorners(first, second, third, fourth *corner, isClockwise bool) {
-	if isClockwise == clockwise {
-		first.colors, second.colors, third.colors, fourth.colors = fourth.colors, first.colors, second.colors, third.colors
-	} else {
-		first.colors, second.colors, third.colors, fourth.colors = second.colors, third.colors, fourth.colors, first.colors
+func (f facelets) permute(permutation facelets) facelets {
+	result := facelets{}
+
+	for i, from := range permutation {
+		result[i] = f[from]
 	}
+
+	return result
 }
 
+// CenterDown rotates the whole cube until the center of the given color is down. The cube itself is
+// reoriented and the rotation is recorded in its history. A color that is not a center returns an error
+// wrapping ErrColor that lists the center colors.
 func (s *Skewb) CenterDown(color string) error {
 	switch {
-	case s.up.color == color:
+	case s.GetUpCenterColor() == color:
 		return s.ApplyWCAMoves(fmt.Sprintf("%v", X2))
-	case s.front.color == color:
+	case s.GetFrontCenterColor() == color:
 		return s.ApplyWCAMoves(fmt.Sprintf("%v", XPrime))
-	case s.right.color == color:
+	case s.GetRightCenterColor() == color:
 		return s.ApplyWCAMoves(fmt.Sprintf("%v", Z))
-	case s.back.color == color:
+	case s.GetBackCenterColor() == color:
 		return s.ApplyWCAMoves(fmt.Sprintf("%v", X))
-	case s.left.color == color:
+	case s.GetLeftCenterColor() == color:
 		return s.ApplyWCAMoves(fmt.Sprintf("%v", ZPrime))
-	case s.down.color == color:
+	case s.GetDownCenterColor() == color:
 		return nil
 	default:
-		return fmt.Errorf("%v %v", color, ErrColor)
+		colors := s.faceletColors()
+
+		return fmt.Errorf("%v %w; center colors are %q", color, ErrColor, colors[24:])
 	}
 }
 
+// CenterDownFace brings the center currently on face, one of "up", "front", "right", "back", "left" and
+// "down", to the bottom like CenterDown does with its color.
+func (s *Skewb) CenterDownFace(face string) error {
+	center := slices.Index(centerNames, face)
+
+	if center == -1 {
+		return fmt.Errorf("%v %w", face, ErrFace)
+	}
+
+	return s.CenterDown(s.centerColor(center))
+}
+
+// Equal reports whether other is the same state held in any of the 24 orientations. CenterDown picks the
+// down face, the only choice that can match, and the y rotations cover the four orientations left, so a
+// solved cube is Equal to itself after x. A *Skewb is searched on a copy and left untouched, any other
+// Skewber is left rotated by the search.
 func (s *Skewb) Equal(other Skewber) bool {
-	other.CenterDown(s.down.color)
+	other = reorientable(other)
+
+	if err := other.CenterDown(s.GetDownCenterColor()); err != nil {
+		return notEqual
+	}
 
 	if s.equal(other) {
 		return equal
 	}
 
 	for range 3 {
-		other.ApplyWCAMoves(fmt.Sprintf("%v", Y))
+		if err := other.ApplyWCAMoves(fmt.Sprintf("%v", Y)); err != nil {
+			return notEqual
+		}
 
 		if s.equal(other) {
 			return equal
@@ -715,40 +691,52 @@ func (s *Skewb) Equal(other Skewber) bool {
 	return notEqual
 }
 
+// reorientable returns a copy of other for the comparisons to rotate when it is a *Skewb, so neither its
+// orientation nor its history and stats change, and other itself otherwise.
+func reorientable(other Skewber) Skewber {
+	if o, ok := other.(*Skewb); ok {
+		clone := o.clone()
+
+		return &clone
+	}
+
+	return other
+}
+
 func (s *Skewb) ExactEqual(other Skewber) bool {
 	return s.equal(other)
 }
 
 func (s *Skewb) equal(other Skewber) bool {
 	switch {
-	case [3]string{s.ufr.colors.first, s.ufr.colors.second, s.ufr.colors.third} != other.GetUFRCornerColors():
+	case s.GetUFRCornerColors() != other.GetUFRCornerColors():
 		return notEqual
-	case [3]string{s.urb.colors.first, s.urb.colors.second, s.urb.colors.third} != other.GetURBCornerColors():
+	case s.GetURBCornerColors() != other.GetURBCornerColors():
 		return notEqual
-	case [3]string{s.ulf.colors.first, s.ulf.colors.second, s.ulf.colors.third} != other.GetULFCornerColors():
+	case s.GetULFCornerColors() != other.GetULFCornerColors():
 		return notEqual
-	case [3]string{s.ubl.colors.first, s.ubl.colors.second, s.ubl.colors.third} != other.GetUBLCornerColors():
+	case s.GetUBLCornerColors() != other.GetUBLCornerColors():
 		return notEqual
-	case [3]string{s.drf.colors.first, s.drf.colors.second, s.drf.colors.third} != other.GetDRFCornerColors():
+	case s.GetDRFCornerColors() != other.GetDRFCornerColors():
 		return notEqual
-	case [3]string{s.dbr.colors.first, s.dbr.colors.second, s.dbr.colors.third} != other.GetDBRCornerColors():
+	case s.GetDBRCornerColors() != other.GetDBRCornerColors():
 		return notEqual
-	case [3]string{s.dfl.colors.first, s.dfl.colors.second, s.dfl.colors.third} != other.GetDFLCornerColors():
+	case s.GetDFLCornerColors() != other.GetDFLCornerColors():
 		return notEqual
-	case [3]string{s.dlb.colors.first, s.dlb.colors.second, s.dlb.colors.third} != other.GetDLBCornerColors():
+	case s.GetDLBCornerColors() != other.GetDLBCornerColors():
 		return notEqual
 
-	case s.up.color != other.GetUpCenterColor():
+	case s.GetUpCenterColor() != other.GetUpCenterColor():
 		return notEqual
-	case s.front.color != other.GetFrontCenterColor():
+	case s.GetFrontCenterColor() != other.GetFrontCenterColor():
 		return notEqual
-	case s.right.color != other.GetRightCenterColor():
+	case s.GetRightCenterColor() != other.GetRightCenterColor():
 		return notEqual
-	case s.back.color != other.GetBackCenterColor():
+	case s.GetBackCenterColor() != other.GetBackCenterColor():
 		return notEqual
-	case s.left.color != other.GetLeftCenterColor():
+	case s.GetLeftCenterColor() != other.GetLeftCenterColor():
 		return notEqual
-	case s.down.color != other.GetDownCenterColor():
+	case s.GetDownCenterColor() != other.GetDownCenterColor():
 		return notEqual
 	}
 
@@ -756,30 +744,48 @@ func (s *Skewb) equal(other Skewber) bool {
 }
 
 func (s *Skewb) OneLayerMirror(other Skewber, layerColor string) bool {
-	s.CenterDown(layerColor)
-	other.CenterDown(s.down.color)
+	mirror, err := s.OneLayerMirrorErr(other, layerColor)
 
-	if s.oneLayerMirror(other, layerColor) {
-		return equal
+	return err == nil && mirror
+}
+
+// OneLayerMirrorErr is OneLayerMirror returning the error of a reorientation that failed instead of
+// reporting the layers as different. Like Equal it rotates copies of the cubes it compares.
+func (s *Skewb) OneLayerMirrorErr(other Skewber, layerColor string) (bool, error) {
+	clone := s.clone()
+	other = reorientable(other)
+
+	if err := clone.CenterDown(layerColor); err != nil {
+		return notEqual, err
+	}
+
+	if err := other.CenterDown(clone.GetDownCenterColor()); err != nil {
+		return notEqual, err
+	}
+
+	if clone.oneLayerMirror(other, layerColor) {
+		return equal, nil
 	}
 
 	rotations := []string{"y", "y'", "y2"}
 
 	for _, rotation := range rotations {
-		other.ApplyWCAMoves(rotation)
+		if err := other.ApplyWCAMoves(rotation); err != nil {
+			return notEqual, err
+		}
 
-		if s.oneLayerMirror(other, layerColor) {
-			reverse := createReverse(rotation)
-			other.ApplyWCAMoves(reverse)
+		mirror := clone.oneLayerMirror(other, layerColor)
 
-			return equal
+		if err := other.ApplyWCAMoves(createReverse(rotation)); err != nil {
+			return notEqual, err
 		}
 
-		reverse := createReverse(rotation)
-		other.ApplyWCAMoves(reverse)
+		if mirror {
+			return equal, nil
+		}
 	}
 
-	return notEqual
+	return notEqual, nil
 }
 
 func (s *Skewb) oneLayerMirror(other Skewber, layerColor string) bool {
@@ -826,6 +832,10 @@ func (s *Skewb) oneLayerMirror(other Skewber, layerColor string) bool {
 		otherLayerCorners = append(otherLayerCorners, other.GetDLBCornerColors())
 	}
 
+	if len(sLayerCorners) != 4 {
+		return notEqual
+	}
+
 	sLayerColors := getLayerColor(sLayerCorners, layerColor)
 	otherLayerColors := getLayerColor(otherLayerCorners, layerColor)
 
@@ -883,27 +893,37 @@ func index(array [3]string, element string) int {
 }
 
 func (s *Skewb) FullMirror(other Skewber) bool {
+	mirror, err := s.FullMirrorErr(other)
+
+	return err == nil && mirror
+}
+
+// FullMirrorErr is FullMirror returning the error of a reorientation that failed instead of reporting the
+// cubes as different. Like Equal it rotates a copy of other.
+func (s *Skewb) FullMirrorErr(other Skewber) (bool, error) {
+	other = reorientable(other)
+
 	if s.fullMirror(other) {
-		return equal
+		return equal, nil
 	}
 
-	rotations := []string{"x", "x'", "x2", "y", "y'", "y2", "z", "z'", "z2", "x y", "x y'", "x y2", "x z", "x z'", "x z2", "x' y", "x' y'", "x' z", "x' z'", "x2 y", "x2 y'", "x2 z", "x2 z'"}
-
-	for _, rotation := range rotations {
-		other.ApplyWCAMoves(rotation)
+	for _, rotation := range orientationRotations()[1:] {
+		if err := other.ApplyWCAMoves(rotation); err != nil {
+			return notEqual, err
+		}
 
-		if s.fullMirror(other) {
-			reverse := createReverse(rotation)
-			other.ApplyWCAMoves(reverse)
+		mirror := s.fullMirror(other)
 
-			return equal
+		if err := other.ApplyWCAMoves(createReverse(rotation)); err != nil {
+			return notEqual, err
 		}
 
-		reverse := createReverse(rotation)
-		other.ApplyWCAMoves(reverse)
+		if mirror {
+			return equal, nil
+		}
 	}
 
-	return notEqual
+	return notEqual, nil
 }
 
 func (s *Skewb) fullMirror(other Skewber) bool {
@@ -917,6 +937,51 @@ func (s *Skewb) fullMirror(other Skewber) bool {
 	return notEqual
 }
 
+// Mirror returns the cube reflected across the plane between the L and R faces ("LR"), the F and B faces
+// ("FB") or the U and D faces ("UD"). Every sticker keeps its color and moves to its mirror position, so
+// the centers on either side of the plane trade colors and every corner takes the opposite handedness. A
+// turn of the cube becomes the inverse turn of the mirrored corner: "R" across "LR" is "B'". Any other
+// plane returns an unchanged copy.
+func (s *Skewb) Mirror(plane string) Skewb {
+	mirror := s.clone()
+
+	if permutation, ok := mirrorPermutations[plane]; ok {
+		mirror.facelets = s.facelets.permute(permutation)
+	}
+
+	return mirror
+}
+
+// MirrorLayer is Mirror across a plane through the center of the given color, so that color keeps its
+// center and its layer turns into the layer the mirrored algorithm solves: a left and a right handed
+// algorithm for the layer relate by it. A color that is not a center returns an error wrapping ErrColor.
+func (s *Skewb) MirrorLayer(color string) (Skewb, error) {
+	switch color {
+	case s.GetUpCenterColor(), s.GetDownCenterColor(), s.GetFrontCenterColor(), s.GetBackCenterColor():
+		return s.Mirror("LR"), nil
+	case s.GetRightCenterColor(), s.GetLeftCenterColor():
+		return s.Mirror("FB"), nil
+	default:
+		return Skewb{}, fmt.Errorf("%v %w", color, ErrColor)
+	}
+}
+
+// PatternEqual reports whether other shows the same pattern as the cube in the same orientation, comparing
+// the RelativeColors of both, so the same scramble applied to cubes of different color schemes is equal.
+// FullMirror compares the same fingerprint but also tries every orientation of other; PatternEqual
+// leaves other untouched.
+func (s *Skewb) PatternEqual(other Skewber) bool {
+	return s.fullMirror(other)
+}
+
+// RelativeColors returns the fingerprint FullMirror compares: every sticker replaced by the face, 0 to 5 in
+// the order up, front, right, back, left, down, whose center has its color. Row i is face i, column 0 its
+// center and columns 1 to 4 its corner stickers in the corner order ufr, urb, ulf, ubl, drf, dbr, dfl, dlb.
+// The fingerprint does not depend on the colors used, only on where they are relative to the centers.
+func (s *Skewb) RelativeColors() [6][5]int {
+	return getRelativeColors(s)
+}
+
 func getRelativeColors(s Skewber) [6][5]int {
 	relativeColors := [6][5]int{}
 
@@ -993,75 +1058,85 @@ func getCornerRelativeColors(colors [3]string, upColor, frontColor, rightColor,
 }
 
 func createReverse(moves string) string {
-	reverse := ""
-
-	for _, move := range strings.Split(moves, " ") {
-		if strings.Contains(move, "'") {
-			move = strings.ReplaceAll(move, "'", "")
-		} else {
-			if !strings.Contains(move, "2") {
-				move += "'"
-			}
-		}
+	reverse := []string{}
 
-		reverse = fmt.Sprintf("%v %v", move, reverse)
+	for _, move := range splitMoves(moves) {
+		reverse = append([]string{string(inverseMove(Move(move)))}, reverse...)
 	}
 
-	return reverse
+	return strings.Join(reverse, " ")
 }
 
 func (s *Skewb) GetUFRCornerColors() [3]string {
-	return [3]string{s.ufr.colors.first, s.ufr.colors.second, s.ufr.colors.third}
+	return s.cornerColors(ufrCorner)
 }
 
 func (s *Skewb) GetURBCornerColors() [3]string {
-	return [3]string{s.urb.colors.first, s.urb.colors.second, s.urb.colors.third}
+	return s.cornerColors(urbCorner)
 }
 
 func (s *Skewb) GetULFCornerColors() [3]string {
-	return [3]string{s.ulf.colors.first, s.ulf.colors.second, s.ulf.colors.third}
+	return s.cornerColors(ulfCorner)
 }
 
 func (s *Skewb) GetUBLCornerColors() [3]string {
-	return [3]string{s.ubl.colors.first, s.ubl.colors.second, s.ubl.colors.third}
+	return s.cornerColors(ublCorner)
 }
 
 func (s *Skewb) GetDRFCornerColors() [3]string {
-	return [3]string{s.drf.colors.first, s.drf.colors.second, s.drf.colors.third}
+	return s.cornerColors(drfCorner)
 }
 
 func (s *Skewb) GetDBRCornerColors() [3]string {
-	return [3]string{s.dbr.colors.first, s.dbr.colors.second, s.dbr.colors.third}
+	return s.cornerColors(dbrCorner)
 }
 
 func (s *Skewb) GetDFLCornerColors() [3]string {
-	return [3]string{s.dfl.colors.first, s.dfl.colors.second, s.dfl.colors.third}
+	return s.cornerColors(dflCorner)
 }
 
 func (s *Skewb) GetDLBCornerColors() [3]string {
-	return [3]string{s.dlb.colors.first, s.dlb.colors.second, s.dlb.colors.third}
+	return s.cornerColors(dlbCorner)
 }
 
 func (s *Skewb) GetUpCenterColor() string {
-	return s.up.color
+	return s.centerColor(upCenter)
 }
 
 func (s *Skewb) GetFrontCenterColor() string {
-	return s.front.color
+	return s.centerColor(frontCenter)
 }
 
 func (s *Skewb) GetRightCenterColor() string {
-	return s.right.color
+	return s.centerColor(rightCenter)
 }
 
 func (s *Skewb) GetBackCenterColor() string {
-	return s.back.color
+	return s.centerColor(backCenter)
 }
 
 func (s *Skewb) GetLeftCenterColor() string {
-	return s.left.color
+	return s.centerColor(leftCenter)
 }
 
 func (s *Skewb) GetDownCenterColor() string {
-	return s.down.color
+	return s.centerColor(downCenter)
+}
+
+func (s *Skewb) cornerColors(corner int) [3]string {
+	return [3]string{s.color(corner * 3), s.color(corner*3 + 1), s.color(corner*3 + 2)}
+}
+
+func (s *Skewb) centerColor(center int) string {
+	return s.color(24 + center)
+}
+
+// color returns the color of a facelet, or "" for the zero Skewb, which has no colors until New or ResetTo
+// gives it some.
+func (s *Skewb) color(facelet int) string {
+	if int(s.facelets[facelet]) >= len(s.colors) {
+		return ""
+	}
+
+	return s.colors[s.facelets[facelet]]
 }
@@ -0,0 +1,454 @@
+package skewb
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// scramble is a realistic WCA scramble with whole cube rotations mixed in, the fixture of the benchmarks.
+var scramble = "R U' B L R' U L' B x R U' B' L y2 U R' B L'"
+
+func TestZeroSkewb(t *testing.T) {
+	s := Skewb{}
+
+	if colors := s.GetUFRCornerColors(); colors != [3]string{} {
+		t.Errorf("got corner colors %q, want empty strings", colors)
+	}
+
+	if color := s.GetDownCenterColor(); color != "" {
+		t.Errorf("got center color %q, want an empty string", color)
+	}
+
+	if err := s.ApplyWCAMoves(scramble); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, color := range s.AllCenterColors() {
+		if color != "" {
+			t.Errorf("%v: got %q, want an empty string", name, color)
+		}
+	}
+
+	other := Skewb{}
+
+	if !s.ExactEqual(&other) || s.IsSolved() {
+		t.Error("zero cubes have to be ExactEqual and not solved")
+	}
+}
+
+// TestManyColors sets more colors one after another than the facelets can index, on a copy sharing the
+// palette of the original.
+func TestManyColors(t *testing.T) {
+	s := New("U", "F", "R", "B", "L", "D")
+	copied := s
+
+	for i := range 1000 {
+		copied.SetUpCenterColor(fmt.Sprint(i))
+		copied.SetUFRCornerColors([3]string{fmt.Sprint(i), "F", fmt.Sprint(-i)})
+	}
+
+	if color := copied.GetUpCenterColor(); color != "999" {
+		t.Errorf("got up center color %q, want 999", color)
+	}
+
+	if colors := copied.GetUFRCornerColors(); colors != [3]string{"999", "F", "-999"} {
+		t.Errorf("got ufr colors %q, want [999 F -999]", colors)
+	}
+
+	if color := copied.GetDownCenterColor(); color != "D" {
+		t.Errorf("got down center color %q, want D", color)
+	}
+
+	if !s.IsSolved() {
+		t.Error("setting the colors of a copy changed the original")
+	}
+}
+
+func BenchmarkApplyWCAMoves(b *testing.B) {
+	for b.Loop() {
+		s := New("U", "F", "R", "B", "L", "D")
+		s.ApplyWCAMoves(scramble)
+	}
+}
+
+// BenchmarkPermute applies the scramble as the permutation tables alone, without parsing or recording it.
+func BenchmarkPermute(b *testing.B) {
+	moves, _ := parseNotationMoves(scramble, wcaMovePermutations, ErrWCAMove)
+
+	for b.Loop() {
+		state := solvedFacelets
+
+		for _, move := range moves {
+			state = state.permute(wcaMovePermutations[move])
+		}
+	}
+}
+
+func TestApplyMovesErrors(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		apply func(*Skewb, string) error
+		moves string
+		err   error
+	}{
+		{name: "wca", apply: (*Skewb).ApplyWCAMoves, moves: "R r", err: ErrWCAMove},
+		{name: "wca", apply: (*Skewb).ApplyWCAMoves, moves: "F", err: ErrWCAMove},
+		{name: "rubiskewb", apply: (*Skewb).ApplyRubiskewbMoves, moves: "r U", err: ErrRubiskewbMove},
+		{name: "rubiskewb", apply: (*Skewb).ApplyRubiskewbMoves, moves: "x Q", err: ErrRubiskewbMove},
+	} {
+		s := New("U", "F", "R", "B", "L", "D")
+
+		err := test.apply(&s, test.moves)
+
+		if !errors.Is(err, test.err) {
+			t.Errorf("%v %q: got %v, want %v", test.name, test.moves, err, test.err)
+		}
+
+		if !errors.Is(err, ErrWCAMove) {
+			t.Errorf("%v %q: got %v, want an error matching ErrWCAMove", test.name, test.moves, err)
+		}
+	}
+}
+
+func BenchmarkParseNotationMoves(b *testing.B) {
+	for b.Loop() {
+		parseNotationMoves(scramble, wcaMovePermutations, ErrWCAMove)
+	}
+}
+
+func TestFullMirror(t *testing.T) {
+	s := New("U", "F", "R", "B", "L", "D")
+	s.ApplyWCAMoves(scramble)
+
+	for _, rotation := range orientationRotations() {
+		other := NewFromScheme(DefaultScheme())
+		other.ApplyWCAMoves(scramble + " " + rotation)
+
+		if !s.FullMirror(&other) {
+			t.Errorf("%q: the recolored scramble is not found", rotation)
+		}
+
+		other.ApplyWCAMoves("R")
+
+		if s.FullMirror(&other) {
+			t.Errorf("%q: a different state is found", rotation)
+		}
+	}
+}
+
+func TestMirror(t *testing.T) {
+	for _, test := range []struct {
+		plane          string
+		apply          func(*Skewb, string) error
+		move, mirrored string
+		scheme         [6]string
+	}{
+		{plane: "LR", apply: (*Skewb).ApplyWCAMoves, move: "R", mirrored: "B'", scheme: [6]string{"U", "F", "L", "B", "R", "D"}},
+		{plane: "LR", apply: (*Skewb).ApplyRubiskewbMoves, move: "F", mirrored: "L'", scheme: [6]string{"U", "F", "L", "B", "R", "D"}},
+		{plane: "FB", apply: (*Skewb).ApplyRubiskewbMoves, move: "R", mirrored: "F'", scheme: [6]string{"U", "B", "R", "F", "L", "D"}},
+		{plane: "FB", apply: (*Skewb).ApplyRubiskewbMoves, move: "b", mirrored: "l'", scheme: [6]string{"U", "B", "R", "F", "L", "D"}},
+		{plane: "UD", apply: (*Skewb).ApplyWCAMoves, move: "U", mirrored: "B'", scheme: [6]string{"D", "F", "R", "B", "L", "U"}},
+		{plane: "UD", apply: (*Skewb).ApplyRubiskewbMoves, move: "R", mirrored: "r'", scheme: [6]string{"D", "F", "R", "B", "L", "U"}},
+	} {
+		s := New("U", "F", "R", "B", "L", "D")
+		test.apply(&s, test.move)
+		mirror := s.Mirror(test.plane)
+
+		want := New(test.scheme[0], test.scheme[1], test.scheme[2], test.scheme[3], test.scheme[4], test.scheme[5])
+		test.apply(&want, test.mirrored)
+
+		if !mirror.ExactEqual(&want) {
+			t.Errorf("%v %q: the mirror is not %q on the reflected cube", test.plane, test.move, test.mirrored)
+		}
+	}
+
+	for _, plane := range []string{"LR", "FB", "UD"} {
+		s := NewFromScheme(DefaultScheme())
+		s.ApplyWCAMoves(scramble)
+		mirror := s.Mirror(plane)
+
+		if s.FullMirror(&mirror) {
+			t.Errorf("%v: the reflection of a scramble has the pattern of the scramble", plane)
+		}
+
+		if twice := mirror.Mirror(plane); !s.ExactEqual(&twice) {
+			t.Errorf("%v: mirroring twice does not give the cube back", plane)
+		}
+	}
+
+	s := New("U", "F", "R", "B", "L", "D")
+
+	if unchanged := s.Mirror("XY"); !s.ExactEqual(&unchanged) {
+		t.Error("an unknown plane changes the cube")
+	}
+}
+
+// TestMirrorLayer checks commutators against their versions of the other hand, which turn the mirrored corners.
+func TestMirrorLayer(t *testing.T) {
+	for _, test := range []struct {
+		color, moves, mirrored string
+		apply                  func(*Skewb, string) error
+		scheme                 [6]string
+	}{
+		{color: "U", moves: "R B R' B'", mirrored: "B' R' B R", apply: (*Skewb).ApplyWCAMoves, scheme: [6]string{"U", "F", "L", "B", "R", "D"}},
+		{color: "D", moves: "R F' R' F", mirrored: "B' L B L'", apply: (*Skewb).ApplyRubiskewbMoves, scheme: [6]string{"U", "F", "L", "B", "R", "D"}},
+		{color: "R", moves: "R b R' b'", mirrored: "F' l' F l", apply: (*Skewb).ApplyRubiskewbMoves, scheme: [6]string{"U", "B", "R", "F", "L", "D"}},
+	} {
+		s := New("U", "F", "R", "B", "L", "D")
+		test.apply(&s, test.moves)
+		m, err := s.MirrorLayer(test.color)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := New(test.scheme[0], test.scheme[1], test.scheme[2], test.scheme[3], test.scheme[4], test.scheme[5])
+		test.apply(&want, test.mirrored)
+
+		if !m.ExactEqual(&want) {
+			t.Errorf("%v %q: the mirror is not %q", test.color, test.moves, test.mirrored)
+		}
+	}
+
+	s := NewFromScheme(DefaultScheme())
+	s.ApplyWCAMoves(scramble)
+
+	for name, color := range s.AllCenterColors() {
+		m, err := s.MirrorLayer(color)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := m.AllCenterColors()[name]; got != color {
+			t.Errorf("%v: the %v center of the mirror is %v", color, name, got)
+		}
+	}
+
+	if _, err := s.MirrorLayer("purple"); !errors.Is(err, ErrColor) {
+		t.Errorf("got %v, want ErrColor", err)
+	}
+}
+
+func TestOneLayerMirrorUnknownColor(t *testing.T) {
+	s := New("U", "F", "R", "B", "L", "D")
+	other := New("U", "F", "R", "B", "L", "D")
+
+	if s.OneLayerMirror(&other, "purple") {
+		t.Error("OneLayerMirror accepted a color that is not on the cube")
+	}
+
+	if mirror, err := s.OneLayerMirrorErr(&other, "purple"); mirror || !errors.Is(err, ErrColor) {
+		t.Errorf("got %v and %v, want false and ErrColor", mirror, err)
+	}
+
+	if !s.OneLayerMirror(&other, "D") {
+		t.Error("a solved cube is not a one layer mirror of itself")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	for _, moves := range []string{"", scramble} {
+		s := New("U", "F", "R", "B", "L", "D")
+		s.ApplyWCAMoves(moves)
+
+		for _, rotation := range orientationRotations() {
+			other := s.clone()
+			other.ApplyWCAMoves(rotation)
+			before := other.clone()
+
+			if !s.Equal(&other) {
+				t.Errorf("%q %q: the rotated cube is not Equal", moves, rotation)
+			}
+
+			if !other.ExactEqual(&before) {
+				t.Errorf("%q %q: Equal changed the other cube", moves, rotation)
+			}
+
+			other.ApplyWCAMoves("R")
+
+			if s.Equal(&other) {
+				t.Errorf("%q %q: a different state is Equal", moves, rotation)
+			}
+		}
+	}
+}
+
+func BenchmarkEqual(b *testing.B) {
+	s := New("U", "F", "R", "B", "L", "D")
+	s.ApplyWCAMoves(scramble)
+	other := s.clone()
+	other.ApplyWCAMoves("x y")
+
+	for b.Loop() {
+		s.Equal(&other)
+	}
+}
+
+func BenchmarkExactEqual(b *testing.B) {
+	s := New("U", "F", "R", "B", "L", "D")
+	s.ApplyWCAMoves(scramble)
+	other := s.clone()
+
+	for b.Loop() {
+		s.ExactEqual(&other)
+	}
+}
+
+// BenchmarkFullMirror compares against the scramble in another color scheme and orientation, so the search
+// tries the orientations before it finds the match.
+func BenchmarkFullMirror(b *testing.B) {
+	s := New("U", "F", "R", "B", "L", "D")
+	s.ApplyWCAMoves(scramble)
+	other := New("1", "2", "3", "4", "5", "6")
+	other.ApplyWCAMoves(scramble + " z'")
+
+	for b.Loop() {
+		s.FullMirror(&other)
+	}
+}
+
+func TestCreateReverse(t *testing.T) {
+	for _, test := range []struct {
+		moves, want string
+	}{
+		{moves: "", want: ""},
+		{moves: "R", want: "R'"},
+		{moves: " R U'  x2\n", want: "x2 U R'"},
+		{moves: "R2 y", want: "y' R"},
+	} {
+		if got := createReverse(test.moves); got != test.want {
+			t.Errorf("%q: got %q, want %q", test.moves, got, test.want)
+		}
+	}
+
+	s := New("U", "F", "R", "B", "L", "D")
+	s.ApplyWCAMoves(scramble)
+	s.ApplyWCAMoves(createReverse(scramble))
+	solved := New("U", "F", "R", "B", "L", "D")
+
+	if !s.ExactEqual(&solved) {
+		t.Error("the reverse of the scramble does not undo it")
+	}
+}
+
+func TestMoveIdentities(t *testing.T) {
+	identities := []string{"", scramble + " " + createReverse(scramble)}
+
+	for _, move := range WCAFaceMoves {
+		identities = append(identities, strings.Repeat(string(move)+" ", 3))
+	}
+
+	for _, move := range WCARotations {
+		identities = append(identities, strings.Repeat(string(move)+" ", 4))
+	}
+
+	for _, first := range slices.Concat(WCAFaceMoves, WCARotations) {
+		for _, second := range slices.Concat(WCAFaceMoves, WCARotations) {
+			pair := joinMoves([]Move{first, second})
+			identities = append(identities, pair+" "+createReverse(pair))
+		}
+	}
+
+	for _, identity := range identities {
+		if err := checkIdentity(identity); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if err := checkIdentity("R R"); !errors.Is(err, ErrIdentity) {
+		t.Errorf("got %v for R R, want ErrIdentity", err)
+	}
+}
+
+func TestMoveEquivalences(t *testing.T) {
+	for _, test := range []struct {
+		a, b string
+	}{
+		{a: "x2", b: "x x"},
+		{a: "y2", b: "y y"},
+		{a: "z2", b: "z z"},
+		{a: "x'", b: "x x x"},
+		{a: "R2", b: "R R"},
+		{a: "R'", b: "R R"},
+		{a: "U2 B'", b: "U' B B"},
+	} {
+		if equivalent, err := MovesEquivalent(test.a, test.b); err != nil || !equivalent {
+			t.Errorf("%q and %q: got %v and %v, want equivalent", test.a, test.b, equivalent, err)
+		}
+	}
+}
+
+// TestCycleLengths repeats each sequence on a solved cube until it is solved again.
+func TestCycleLengths(t *testing.T) {
+	for _, test := range []struct {
+		moves  string
+		length int
+	}{
+		{moves: "R", length: 3},
+		{moves: "x", length: 4},
+		{moves: "R B", length: 9},
+		{moves: "R B'", length: 18},
+		{moves: "R U", length: 45},
+		{moves: "R L", length: 45},
+		{moves: "R U R' U'", length: 6},
+		{moves: "y R", length: 36},
+	} {
+		s := New("U", "F", "R", "B", "L", "D")
+		solved := New("U", "F", "R", "B", "L", "D")
+		length := 0
+
+		for length < 1000 {
+			if err := s.ApplyWCAMoves(test.moves); err != nil {
+				t.Fatal(err)
+			}
+
+			if length++; s.ExactEqual(&solved) {
+				break
+			}
+		}
+
+		if length != test.length {
+			t.Errorf("(%v)^n: got cycle length %v, want %v", test.moves, length, test.length)
+		}
+	}
+}
+
+func TestDoubleMoves(t *testing.T) {
+	for _, test := range []struct {
+		apply  func(*Skewb, string) error
+		double Move
+	}{
+		{apply: (*Skewb).ApplyWCAMoves, double: U2},
+		{apply: (*Skewb).ApplyWCAMoves, double: R2},
+		{apply: (*Skewb).ApplyWCAMoves, double: B2},
+		{apply: (*Skewb).ApplyWCAMoves, double: L2},
+		{apply: (*Skewb).ApplyWCAMoves, double: X2},
+		{apply: (*Skewb).ApplyWCAMoves, double: Y2},
+		{apply: (*Skewb).ApplyWCAMoves, double: Z2},
+		{apply: (*Skewb).ApplyRubiskewbMoves, double: F2},
+		{apply: (*Skewb).ApplyRubiskewbMoves, double: LittleF2},
+		{apply: (*Skewb).ApplyRubiskewbMoves, double: X2},
+	} {
+		single := strings.TrimSuffix(string(test.double), "2")
+		s := New("U", "F", "R", "B", "L", "D")
+		twice := New("U", "F", "R", "B", "L", "D")
+		s.ApplyWCAMoves(scramble)
+		twice.ApplyWCAMoves(scramble)
+
+		if err := test.apply(&s, string(test.double)); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := test.apply(&twice, single+" "+single); err != nil {
+			t.Fatal(err)
+		}
+
+		if !s.ExactEqual(&twice) {
+			t.Errorf("%v is not %v %v", test.double, single, single)
+		}
+	}
+}
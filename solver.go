@@ -0,0 +1,368 @@
+package skewb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"strings"
+	"sync"
+	"time"
+)
+
+type NearestSolveder interface {
+	NearestSolved() (string, int)
+}
+
+type ContextSolver interface {
+	SolveContext(ctx context.Context) ([]Move, error)
+}
+
+type StreamSolver interface {
+	SolveStream(ctx context.Context) (<-chan []Move, error)
+}
+
+type Distancer interface {
+	Distance() (int, error)
+}
+
+var (
+	ErrUnsolvable = errors.New("skewb state can not be solved")
+
+	godsNumber   = 11
+	pruningDepth = 6
+	solverOnce   sync.Once
+	pruningTable map[facelets]uint8
+)
+
+func (s *Skewb) NearestSolved() (string, int) {
+	solution, err := s.solve()
+
+	if err != nil {
+		return "", -1
+	}
+
+	return joinMoves(solution), len(solution)
+}
+
+// Distance looks the number of moves of an optimal solution up in the distance table instead of searching
+// for the solution itself.
+func (s *Skewb) Distance() (int, error) {
+	start, err := s.relativeFacelets()
+
+	if err != nil {
+		return 0, err
+	}
+
+	initTable()
+
+	index := tableIndex(start)
+
+	if index == -1 || distances[index] == unknownDistance {
+		return 0, ErrUnsolvable
+	}
+
+	return int(distances[index]), nil
+}
+
+// EffectiveLength applies the WCA moves to a solved cube and returns its Distance, so a scramble whose turns
+// partly cancel reports how many moves it is really worth.
+func EffectiveLength(moves string) (int, error) {
+	s := New("U", "F", "R", "B", "L", "D")
+
+	if err := s.ApplyWCAMoves(moves); err != nil {
+		return 0, err
+	}
+
+	return s.Distance()
+}
+
+func (s *Skewb) solve() ([]Move, error) {
+	return s.SolveContext(context.Background())
+}
+
+// SolveContext searches an optimal solution like NearestSolved and gives up with the error of ctx once it is
+// canceled or its deadline passes. The search checks ctx at every node it visits.
+func (s *Skewb) SolveContext(ctx context.Context) ([]Move, error) {
+	start, err := s.relativeFacelets()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return solveFaceletsContext(ctx, start)
+}
+
+// SolveStream sends solutions of decreasing length on the returned channel: first the layer by layer solution
+// of SolveLayered, which is found at once, then the optimal one of SolveContext if it is shorter. The channel
+// is closed after the optimal solution, or early without it once ctx is canceled. States that can not be
+// solved return an error and no channel.
+func (s *Skewb) SolveStream(ctx context.Context) (<-chan []Move, error) {
+	start, err := s.relativeFacelets()
+
+	if err != nil {
+		return nil, err
+	}
+
+	layered, err := s.SolveLayered()
+
+	if err != nil {
+		return nil, err
+	}
+
+	solutions := make(chan []Move)
+
+	go func() {
+		defer close(solutions)
+
+		select {
+		case solutions <- layered:
+		case <-ctx.Done():
+			return
+		}
+
+		optimal, err := solveFaceletsContext(ctx, start)
+
+		if err != nil || len(optimal) == len(layered) {
+			return
+		}
+
+		select {
+		case solutions <- optimal:
+		case <-ctx.Done():
+		}
+	}()
+
+	return solutions, nil
+}
+
+func CompareSolvers(scrambles []string) (tableAvg, idaAvg time.Duration, err error) {
+	if len(scrambles) == 0 {
+		return 0, 0, nil
+	}
+
+	initTable()
+
+	for _, scramble := range scrambles {
+		s := New("U", "F", "R", "B", "L", "D")
+
+		if err := s.ApplyWCAMoves(scramble); err != nil {
+			return 0, 0, err
+		}
+
+		start, err := s.relativeFacelets()
+
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, solver := range []struct {
+			solve func(facelets) ([]Move, error)
+			total *time.Duration
+		}{
+			{solve: tableSolveFacelets, total: &tableAvg},
+			{solve: solveFacelets, total: &idaAvg},
+		} {
+			previousTime := time.Now()
+			solution, err := solver.solve(start)
+			*solver.total += time.Since(previousTime)
+
+			if err != nil {
+				return 0, 0, err
+			}
+
+			state := start
+
+			for _, move := range solution {
+				state = state.apply(move)
+			}
+
+			if state != solvedFacelets {
+				return 0, 0, fmt.Errorf("%v %w", scramble, ErrUnsolvable)
+			}
+		}
+	}
+
+	return tableAvg / time.Duration(len(scrambles)), idaAvg / time.Duration(len(scrambles)), nil
+}
+
+func solveFacelets(start facelets) ([]Move, error) {
+	return solveFaceletsContext(context.Background(), start)
+}
+
+func solveFaceletsContext(ctx context.Context, start facelets) ([]Move, error) {
+	initSolver()
+
+	path := make([]Move, 0, godsNumber)
+
+	for limit := 0; limit <= godsNumber; limit++ {
+		if solution, found := search(ctx, start, limit, path); found {
+			return solution, nil
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, ErrUnsolvable
+}
+
+func search(ctx context.Context, state facelets, limit int, path []Move) ([]Move, bool) {
+	if state == solvedFacelets {
+		return path, true
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, false
+	default:
+	}
+
+	if len(path)+heuristic(state) > limit {
+		return nil, false
+	}
+
+	for _, move := range WCAFaceMoves {
+		if len(path) > 0 && sameAxis(path[len(path)-1], move) {
+			continue
+		}
+
+		if solution, found := search(ctx, state.apply(move), limit, append(path, move)); found {
+			return solution, true
+		}
+	}
+
+	return nil, false
+}
+
+func heuristic(state facelets) int {
+	if distance, ok := pruningTable[state]; ok {
+		return int(distance)
+	}
+
+	return pruningDepth + 1
+}
+
+func sameAxis(first, second Move) bool {
+	return strings.TrimSuffix(string(first), "'") == strings.TrimSuffix(string(second), "'")
+}
+
+func (f facelets) apply(move Move) facelets {
+	return f.permute(wcaPermutations[move])
+}
+
+func initSolver() {
+	solverOnce.Do(func() {
+		pruningTable = map[facelets]uint8{solvedFacelets: 0}
+		frontier := []facelets{solvedFacelets}
+
+		for depth := 1; depth <= pruningDepth; depth++ {
+			next := []facelets{}
+
+			for _, state := range frontier {
+				for _, move := range WCAFaceMoves {
+					newState := state.apply(move)
+
+					if _, ok := pruningTable[newState]; !ok {
+						pruningTable[newState] = uint8(depth)
+						next = append(next, newState)
+					}
+				}
+			}
+
+			frontier = next
+		}
+	})
+}
+
+func (s *Skewb) faceletColors() [30]string {
+	colors := [30]string{}
+
+	for i := range s.facelets {
+		colors[i] = s.color(i)
+	}
+
+	return colors
+}
+
+func (s *Skewb) setFaceletColors(colors [30]string) {
+	for i, color := range colors {
+		s.facelets[i] = s.colorIndex(color)
+	}
+}
+
+func (s *Skewb) relativeFacelets() (facelets, error) {
+	colors := s.faceletColors()
+	upColor, frontColor, rightColor := colors[0], colors[1], colors[2]
+	codes := map[string]int8{upColor: 0, frontColor: 1, rightColor: 2}
+
+	for color, code := range map[string]int8{frontColor: 3, rightColor: 4, upColor: 5} {
+		opposite, err := s.oppositeColor(color)
+
+		if err != nil {
+			return facelets{}, err
+		}
+
+		codes[opposite] = code
+	}
+
+	if len(codes) != 6 {
+		return facelets{}, ErrUnsolvable
+	}
+
+	result := facelets{}
+
+	for i, color := range colors {
+		code, ok := codes[color]
+
+		if !ok {
+			return facelets{}, ErrUnsolvable
+		}
+
+		result[i] = code
+	}
+
+	return result, nil
+}
+
+func (s *Skewb) oppositeColor(color string) (string, error) {
+	colors := s.faceletColors()
+	neighbours := map[string]bool{color: true}
+
+	for i := 0; i < 24; i += 3 {
+		if index([3]string{colors[i], colors[i+1], colors[i+2]}, color) != -1 {
+			neighbours[colors[i]] = true
+			neighbours[colors[i+1]] = true
+			neighbours[colors[i+2]] = true
+		}
+	}
+
+	opposite := ""
+
+	for _, centerColor := range colors[24:] {
+		if !neighbours[centerColor] {
+			if opposite != "" && opposite != centerColor {
+				return "", ErrUnsolvable
+			}
+
+			opposite = centerColor
+		}
+	}
+
+	if opposite == "" {
+		return "", ErrUnsolvable
+	}
+
+	return opposite, nil
+}
+
+func joinMoves(moves []Move) string {
+	result := make([]string, len(moves))
+
+	for i, move := range moves {
+		result[i] = string(move)
+	}
+
+	return strings.Join(result, " ")
+}
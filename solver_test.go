@@ -0,0 +1,54 @@
+package skewb
+
+import "testing"
+
+func TestNearestSolved(t *testing.T) {
+	for _, test := range []struct {
+		scramble string
+		length   int
+	}{
+		{scramble: "", length: 0},
+		{scramble: "x y", length: 0},
+		{scramble: "R", length: 1},
+		{scramble: "U'", length: 1},
+		{scramble: "y B", length: 1},
+		{scramble: "R L", length: 2},
+		{scramble: "R U' B L'", length: 4},
+	} {
+		s := New("U", "F", "R", "B", "L", "D")
+
+		if err := s.ApplyWCAMoves(test.scramble); err != nil {
+			t.Fatal(err)
+		}
+
+		solution, length := s.NearestSolved()
+
+		if length != test.length {
+			t.Errorf("%q: got length %v, want %v", test.scramble, length, test.length)
+		}
+
+		if err := s.ApplyWCAMoves(solution); err != nil {
+			t.Fatal(err)
+		}
+
+		if !s.IsSolved() {
+			t.Errorf("%q: %q does not solve the cube", test.scramble, solution)
+		}
+	}
+}
+
+func TestCompareSolvers(t *testing.T) {
+	tableAverage, idaAverage, err := CompareSolvers([]string{"R U' B L'", "R L R' L' U B", "U R B L U' R' B' L"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tableAverage <= 0 || idaAverage <= 0 {
+		t.Errorf("got averages %v and %v, want positive durations", tableAverage, idaAverage)
+	}
+
+	if _, _, err := CompareSolvers([]string{"R Q"}); err == nil {
+		t.Error("CompareSolvers accepted an invalid scramble")
+	}
+}
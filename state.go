@@ -0,0 +1,341 @@
+package skewb
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+type StateGetter interface {
+	AllCornerColors() map[string][3]string
+	AllCenterColors() map[string]string
+	ColorLocations(color string) []string
+}
+
+var (
+	cornerNames = []string{"ufr", "urb", "ulf", "ubl", "drf", "dbr", "dfl", "dlb"}
+	centerNames = []string{"up", "front", "right", "back", "left", "down"}
+)
+
+func (s *Skewb) AllCornerColors() map[string][3]string {
+	colors := s.faceletColors()
+	corners := map[string][3]string{}
+
+	for i, name := range cornerNames {
+		corners[name] = [3]string{colors[i*3], colors[i*3+1], colors[i*3+2]}
+	}
+
+	return corners
+}
+
+func (s *Skewb) AllCenterColors() map[string]string {
+	colors := s.faceletColors()
+	centers := map[string]string{}
+
+	for i, name := range centerNames {
+		centers[name] = colors[24+i]
+	}
+
+	return centers
+}
+
+// ColorLocations lists every sticker with the given color, corners first as the corner name and the index
+// into its AllCornerColors colors, like "ufr 2", then centers by face name, like "left". A correctly scanned
+// cube lists each of its colors five times.
+func (s *Skewb) ColorLocations(color string) []string {
+	locations := []string{}
+
+	for i, facelet := range s.faceletColors() {
+		if facelet != color {
+			continue
+		}
+
+		if i >= 24 {
+			locations = append(locations, centerNames[i-24])
+		} else {
+			locations = append(locations, fmt.Sprintf("%v %v", cornerNames[i/3], i%3))
+		}
+	}
+
+	return locations
+}
+
+type StateSetter interface {
+	CornerColorsSetter
+	CenterColorSetter
+}
+
+type CornerColorsSetter interface {
+	SetUFRCornerColors(colors [3]string)
+	SetURBCornerColors(colors [3]string)
+	SetULFCornerColors(colors [3]string)
+	SetUBLCornerColors(colors [3]string)
+	SetDRFCornerColors(colors [3]string)
+	SetDBRCornerColors(colors [3]string)
+	SetDFLCornerColors(colors [3]string)
+	SetDLBCornerColors(colors [3]string)
+}
+
+type CenterColorSetter interface {
+	SetUpCenterColor(color string)
+	SetFrontCenterColor(color string)
+	SetRightCenterColor(color string)
+	SetBackCenterColor(color string)
+	SetLeftCenterColor(color string)
+	SetDownCenterColor(color string)
+}
+
+func (s *Skewb) SetUFRCornerColors(colors [3]string) {
+	s.setCornerColors(ufrCorner, colors)
+}
+
+func (s *Skewb) SetURBCornerColors(colors [3]string) {
+	s.setCornerColors(urbCorner, colors)
+}
+
+func (s *Skewb) SetULFCornerColors(colors [3]string) {
+	s.setCornerColors(ulfCorner, colors)
+}
+
+func (s *Skewb) SetUBLCornerColors(colors [3]string) {
+	s.setCornerColors(ublCorner, colors)
+}
+
+func (s *Skewb) SetDRFCornerColors(colors [3]string) {
+	s.setCornerColors(drfCorner, colors)
+}
+
+func (s *Skewb) SetDBRCornerColors(colors [3]string) {
+	s.setCornerColors(dbrCorner, colors)
+}
+
+func (s *Skewb) SetDFLCornerColors(colors [3]string) {
+	s.setCornerColors(dflCorner, colors)
+}
+
+func (s *Skewb) SetDLBCornerColors(colors [3]string) {
+	s.setCornerColors(dlbCorner, colors)
+}
+
+func (s *Skewb) setCornerColors(corner int, colors [3]string) {
+	for i, color := range colors {
+		s.facelets[corner*3+i] = s.colorIndex(color)
+	}
+}
+
+func (s *Skewb) SetUpCenterColor(color string) {
+	s.facelets[24+upCenter] = s.colorIndex(color)
+}
+
+func (s *Skewb) SetFrontCenterColor(color string) {
+	s.facelets[24+frontCenter] = s.colorIndex(color)
+}
+
+func (s *Skewb) SetRightCenterColor(color string) {
+	s.facelets[24+rightCenter] = s.colorIndex(color)
+}
+
+func (s *Skewb) SetBackCenterColor(color string) {
+	s.facelets[24+backCenter] = s.colorIndex(color)
+}
+
+func (s *Skewb) SetLeftCenterColor(color string) {
+	s.facelets[24+leftCenter] = s.colorIndex(color)
+}
+
+func (s *Skewb) SetDownCenterColor(color string) {
+	s.facelets[24+downCenter] = s.colorIndex(color)
+}
+
+type Snapshotter interface {
+	State() State
+	SetState(state State)
+}
+
+// State holds only the colors of a Skewb, corners in ufr, urb, ulf, ubl, drf, dbr, dfl, dlb order and
+// centers in up, front, right, back, left, down order. It is comparable with == and usable as a map key.
+type State struct {
+	Corners [8][3]string
+	Centers [6]string
+}
+
+func (s *Skewb) State() State {
+	colors := s.faceletColors()
+	state := State{}
+
+	for i := range state.Corners {
+		state.Corners[i] = [3]string{colors[i*3], colors[i*3+1], colors[i*3+2]}
+	}
+
+	copy(state.Centers[:], colors[24:])
+
+	return state
+}
+
+func (s *Skewb) SetState(state State) {
+	colors := [30]string{}
+
+	for i, corner := range state.Corners {
+		copy(colors[i*3:], corner[:])
+	}
+
+	copy(colors[24:], state.Centers[:])
+	s.setFaceletColors(colors)
+}
+
+// maxColors is the number of colors the int8 facelets can index.
+const maxColors = math.MaxInt8 + 1
+
+// colorIndex returns the index of color in the palette, adding it if it is new. A full palette first drops
+// the colors no facelet uses any more, which leaves room because a Skewb shows at most 30 colors.
+func (s *Skewb) colorIndex(color string) int8 {
+	if i := slices.Index(s.colors, color); i != -1 {
+		return int8(i)
+	}
+
+	if len(s.colors) >= maxColors {
+		s.compactColors()
+	}
+
+	s.colors = append(slices.Clip(s.colors), color)
+
+	return int8(len(s.colors) - 1)
+}
+
+// compactColors replaces the palette, which clones may share, with the colors the facelets use, kept in
+// their order.
+func (s *Skewb) compactColors() {
+	used := [maxColors]bool{}
+
+	for _, index := range s.facelets {
+		used[index] = true
+	}
+
+	colors := []string{}
+	indexes := [maxColors]int8{}
+
+	for i, color := range s.colors {
+		if used[i] {
+			indexes[i] = int8(len(colors))
+			colors = append(colors, color)
+		}
+	}
+
+	for i, index := range s.facelets {
+		s.facelets[i] = indexes[index]
+	}
+
+	s.colors = colors
+}
+
+type FaceletsGetter interface {
+	Facelets() string
+	FaceletsInOrder(order [6]string) (string, error)
+}
+
+var (
+	ErrFacelets  = errors.New("facelet string is not a skewb: it needs 30 letters, 5 of each of U, R, F, D, L and B, with the face's own letter on every center")
+	ErrFaceOrder = errors.New("face order has to name each of the six faces once")
+
+	// FaceOrderURFDLB is the face order of Facelets and NewFromFacelets, the one Kociemba style solvers use.
+	FaceOrderURFDLB = [6]string{"up", "right", "front", "down", "left", "back"}
+	// FaceOrderUFRBLD lists the faces in the order of the center colors New takes.
+	FaceOrderUFRBLD = [6]string{"up", "front", "right", "back", "left", "down"}
+
+	faceletFaces  = "URFDLB"
+	centerLetters = "UFRBLD"
+	// faceletOrder lists the facelets of the U, R, F, D, L and B faces, each as its center followed by the
+	// corners clockwise from the top left, looking at the face with U up, B up for the U face and F up for
+	// the D face.
+	faceletOrder = [30]int{24, 9, 3, 0, 6, 26, 2, 4, 17, 13, 25, 8, 1, 14, 19, 29, 18, 12, 15, 21, 28, 11, 7, 20, 22, 27, 5, 10, 23, 16}
+)
+
+// Facelets writes the cube as 30 face letters in faceletOrder, every sticker named after the face whose
+// center has its color, so a solved cube is "UUUUURRRRRFFFFFDDDDDLLLLLBBBBB". Colors that are not on a
+// center are written as "?".
+func (s *Skewb) Facelets() string {
+	facelets, _ := s.FaceletsInOrder(FaceOrderURFDLB)
+
+	return facelets
+}
+
+// FaceletsInOrder is Facelets with the five letter blocks of the faces in the given order, so a solved cube
+// is "UUUUUFFFFFRRRRRBBBBBLLLLLDDDDD" in FaceOrderUFRBLD.
+func (s *Skewb) FaceletsInOrder(order [6]string) (string, error) {
+	positions, _, err := faceletPositions(order)
+
+	if err != nil {
+		return "", err
+	}
+
+	colors := s.faceletColors()
+	result := strings.Builder{}
+
+	for _, i := range positions {
+		if center := slices.Index(colors[24:], colors[i]); center != -1 {
+			result.WriteByte(centerLetters[center])
+		} else {
+			result.WriteByte('?')
+		}
+	}
+
+	return result.String(), nil
+}
+
+// NewFromFacelets builds the cube Facelets describes, using the face letters as its colors.
+func NewFromFacelets(faceletString string) (Skewb, error) {
+	return NewFromFaceletsInOrder(faceletString, FaceOrderURFDLB)
+}
+
+// NewFromFaceletsInOrder builds the cube FaceletsInOrder describes with the same order.
+func NewFromFaceletsInOrder(faceletString string, order [6]string) (Skewb, error) {
+	positions, letters, err := faceletPositions(order)
+
+	if err != nil {
+		return Skewb{}, err
+	}
+
+	if len(faceletString) != len(positions) {
+		return Skewb{}, ErrFacelets
+	}
+
+	for i, face := range letters {
+		if strings.Count(faceletString, string(face)) != 5 || rune(faceletString[i*5]) != face {
+			return Skewb{}, ErrFacelets
+		}
+	}
+
+	colors := [30]string{}
+
+	for i, facelet := range positions {
+		colors[facelet] = faceletString[i : i+1]
+	}
+
+	s := New("U", "F", "R", "B", "L", "D")
+	s.setFaceletColors(colors)
+
+	return s, nil
+}
+
+// faceletPositions reorders the blocks of faceletOrder to follow order and returns the face letters in that
+// order.
+func faceletPositions(order [6]string) ([30]int, string, error) {
+	positions := [30]int{}
+	letters := strings.Builder{}
+
+	for i, face := range order {
+		center := slices.Index(centerNames, face)
+
+		if center == -1 || slices.Index(order[:i], face) != -1 {
+			return [30]int{}, "", fmt.Errorf("%v %w", order, ErrFaceOrder)
+		}
+
+		block := strings.IndexByte(faceletFaces, centerLetters[center])
+		copy(positions[i*5:], faceletOrder[block*5:block*5+5])
+		letters.WriteByte(centerLetters[center])
+	}
+
+	return positions, letters.String(), nil
+}
@@ -0,0 +1,184 @@
+package skewb
+
+import "sync"
+
+type cornerFacelets [24]int8
+
+type centerFacelets [6]int8
+
+var (
+	tableOnce     sync.Once
+	cornerStates  []cornerFacelets
+	cornerIndexes map[cornerFacelets]int
+	centerStates  []centerFacelets
+	centerIndexes map[centerFacelets]int
+	cornerMoves   [][]int
+	centerMoves   [][]int
+	distances     []uint8
+
+	unknownDistance uint8 = 255
+)
+
+func initTable() {
+	tableOnce.Do(func() {
+		initSolver()
+
+		cornerStates, cornerIndexes = enumerateCorners()
+		centerStates, centerIndexes = enumerateCenters()
+		cornerMoves = make([][]int, len(cornerStates))
+		centerMoves = make([][]int, len(centerStates))
+
+		for i, corners := range cornerStates {
+			state := facelets{}
+			copy(state[:], corners[:])
+			cornerMoves[i] = make([]int, len(WCAFaceMoves))
+
+			for j, move := range WCAFaceMoves {
+				cornerMoves[i][j] = cornerIndexes[state.apply(move).corners()]
+			}
+		}
+
+		for i, centers := range centerStates {
+			state := facelets{}
+			copy(state[24:], centers[:])
+			centerMoves[i] = make([]int, len(WCAFaceMoves))
+
+			for j, move := range WCAFaceMoves {
+				centerMoves[i][j] = centerIndexes[state.apply(move).centers()]
+			}
+		}
+
+		distances = make([]uint8, len(cornerStates)*len(centerStates))
+
+		for i := range distances {
+			distances[i] = unknownDistance
+		}
+
+		distances[tableIndex(solvedFacelets)] = 0
+
+		for depth, found := uint8(0), true; found; depth++ {
+			found = false
+
+			for i, distance := range distances {
+				if distance != depth {
+					continue
+				}
+
+				cornerIndex, centerIndex := i/len(centerStates), i%len(centerStates)
+
+				for j := range WCAFaceMoves {
+					next := cornerMoves[cornerIndex][j]*len(centerStates) + centerMoves[centerIndex][j]
+
+					if distances[next] == unknownDistance {
+						distances[next] = depth + 1
+						found = true
+					}
+				}
+			}
+		}
+	})
+}
+
+func enumerateCorners() ([]cornerFacelets, map[cornerFacelets]int) {
+	solved := solvedFacelets.corners()
+	states := []cornerFacelets{solved}
+	indexes := map[cornerFacelets]int{solved: 0}
+
+	for i := 0; i < len(states); i++ {
+		state := facelets{}
+		copy(state[:], states[i][:])
+
+		for _, move := range WCAFaceMoves {
+			next := state.apply(move).corners()
+
+			if _, ok := indexes[next]; !ok {
+				indexes[next] = len(states)
+				states = append(states, next)
+			}
+		}
+	}
+
+	return states, indexes
+}
+
+func enumerateCenters() ([]centerFacelets, map[centerFacelets]int) {
+	solved := solvedFacelets.centers()
+	states := []centerFacelets{solved}
+	indexes := map[centerFacelets]int{solved: 0}
+
+	for i := 0; i < len(states); i++ {
+		state := facelets{}
+		copy(state[24:], states[i][:])
+
+		for _, move := range WCAFaceMoves {
+			next := state.apply(move).centers()
+
+			if _, ok := indexes[next]; !ok {
+				indexes[next] = len(states)
+				states = append(states, next)
+			}
+		}
+	}
+
+	return states, indexes
+}
+
+func (f facelets) corners() cornerFacelets {
+	return cornerFacelets(f[:24])
+}
+
+func (f facelets) centers() centerFacelets {
+	return centerFacelets(f[24:])
+}
+
+func tableIndex(state facelets) int {
+	cornerIndex, ok := cornerIndexes[state.corners()]
+
+	if !ok {
+		return -1
+	}
+
+	centerIndex, ok := centerIndexes[state.centers()]
+
+	if !ok {
+		return -1
+	}
+
+	return cornerIndex*len(centerStates) + centerIndex
+}
+
+func tableState(index int) facelets {
+	state := facelets{}
+	copy(state[:24], cornerStates[index/len(centerStates)][:])
+	copy(state[24:], centerStates[index%len(centerStates)][:])
+
+	return state
+}
+
+func tableSolveFacelets(start facelets) ([]Move, error) {
+	initTable()
+
+	index := tableIndex(start)
+
+	if index == -1 || distances[index] == unknownDistance {
+		return nil, ErrUnsolvable
+	}
+
+	solution := []Move{}
+	cornerIndex, centerIndex := index/len(centerStates), index%len(centerStates)
+
+	for distance := distances[index]; distance > 0; distance-- {
+		for j, move := range WCAFaceMoves {
+			nextCorner, nextCenter := cornerMoves[cornerIndex][j], centerMoves[centerIndex][j]
+
+			if distances[nextCorner*len(centerStates)+nextCenter] == distance-1 {
+				solution = append(solution, move)
+				cornerIndex, centerIndex = nextCorner, nextCenter
+
+				break
+			}
+		}
+	}
+
+	return solution, nil
+}
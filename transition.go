@@ -0,0 +1,107 @@
+package skewb
+
+import (
+	"slices"
+	"strings"
+)
+
+// TransitionMoves returns the shortest sequence of WCA face turns that turns from into to under
+// ExactEqual, preceded by the whole cube rotation that lines up their ufr corners when they are held
+// differently.
+func TransitionMoves(from, to Skewb) (string, error) {
+	target := from.clone()
+	target.setFaceletColors(to.faceletColors())
+
+	for _, rotation := range orientationRotations() {
+		start := from.clone()
+
+		if rotation != "" {
+			start.ApplyWCAMoves(rotation)
+		}
+
+		if start.cornerColors(ufrCorner) != target.cornerColors(ufrCorner) {
+			continue
+		}
+
+		moves, err := bidirectionalSearch(start.facelets, target.facelets)
+
+		if err != nil {
+			return "", err
+		}
+
+		return strings.TrimSpace(rotation + " " + joinMoves(moves)), nil
+	}
+
+	return "", ErrUnsolvable
+}
+
+func bidirectionalSearch(start, target facelets) ([]Move, error) {
+	forward := map[facelets]Move{start: ""}
+	backward := map[facelets]Move{target: ""}
+	forwardFrontier, backwardFrontier := []facelets{start}, []facelets{target}
+
+	if start == target {
+		return []Move{}, nil
+	}
+
+	for depth := 0; depth < godsNumber && len(forwardFrontier) > 0 && len(backwardFrontier) > 0; depth++ {
+		meeting, found := facelets{}, false
+
+		if len(forwardFrontier) <= len(backwardFrontier) {
+			forwardFrontier, meeting, found = expandFrontier(forwardFrontier, forward, backward)
+		} else {
+			backwardFrontier, meeting, found = expandFrontier(backwardFrontier, backward, forward)
+		}
+
+		if found {
+			return joinPaths(meeting, forward, backward), nil
+		}
+	}
+
+	return nil, ErrUnsolvable
+}
+
+// expandFrontier stores, for every new state, the move that reached it, and stops at the first state the
+// search from the other side has already seen.
+func expandFrontier(frontier []facelets, seen, other map[facelets]Move) ([]facelets, facelets, bool) {
+	next := []facelets{}
+
+	for _, state := range frontier {
+		for _, move := range WCAFaceMoves {
+			newState := state.apply(move)
+
+			if _, ok := seen[newState]; ok {
+				continue
+			}
+
+			seen[newState] = move
+
+			if _, ok := other[newState]; ok {
+				return next, newState, true
+			}
+
+			next = append(next, newState)
+		}
+	}
+
+	return next, facelets{}, false
+}
+
+func joinPaths(meeting facelets, forward, backward map[facelets]Move) []Move {
+	moves := []Move{}
+
+	for state := meeting; forward[state] != ""; {
+		moves = append(moves, forward[state])
+		state = state.apply(inverseMove(forward[state]))
+	}
+
+	slices.Reverse(moves)
+
+	for state := meeting; backward[state] != ""; {
+		move := inverseMove(backward[state])
+		moves = append(moves, move)
+		state = state.apply(move)
+	}
+
+	return moves
+}
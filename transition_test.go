@@ -0,0 +1,33 @@
+package skewb
+
+import "testing"
+
+func TestTransitionMoves(t *testing.T) {
+	for _, test := range []struct {
+		from, to string
+	}{
+		{from: "", to: ""},
+		{from: "", to: "R U' B"},
+		{from: "R U'", to: "L' B R"},
+		{from: "", to: "x y"},
+		{from: "R", to: "z L B'"},
+	} {
+		from := New("U", "F", "R", "B", "L", "D")
+		to := New("U", "F", "R", "B", "L", "D")
+		from.ApplyWCAMoves(test.from)
+		to.ApplyWCAMoves(test.to)
+		moves, err := TransitionMoves(from, to)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := from.ApplyWCAMoves(moves); err != nil {
+			t.Fatal(err)
+		}
+
+		if !from.ExactEqual(&to) {
+			t.Errorf("%q to %q: %q does not reach the target", test.from, test.to, moves)
+		}
+	}
+}